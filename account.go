@@ -37,7 +37,7 @@ func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
 	if err != nil {
 		return nil, err
 	}
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Account{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetAccount").SetResult(&Account{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -141,6 +140,10 @@ const (
 	EntityDisk         EntityType = "disk"
 	EntityDomain       EntityType = "domain"
 	EntityNodebalancer EntityType = "nodebalancer"
+	EntityImage        EntityType = "image"
+	EntityStackScript  EntityType = "stackscript"
+	EntityVolume       EntityType = "volume"
+	EntityTicket       EntityType = "ticket"
 )
 
 // EventStatus constants start with Event and include Linode API Event Status values
@@ -172,28 +175,19 @@ type EventsPagedResponse struct {
 	Data []Event `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Event
-func (EventsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Events.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // endpointWithID gets the endpoint URL for a specific Event
-func (e Event) endpointWithID(c *Client) string {
+func (e Event) endpointWithID(c *Client) (string, error) {
 	endpoint, err := c.Events.Endpoint()
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 	endpoint = fmt.Sprintf("%s/%d", endpoint, e.ID)
-	return endpoint
+	return endpoint, nil
 }
 
 // appendData appends Events when processing paginated Event responses
-func (resp *EventsPagedResponse) appendData(r *EventsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *EventsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*EventsPagedResponse).Data...)
 }
 
 // ListEvents gets a collection of Event objects representing actions taken
@@ -201,7 +195,7 @@ func (resp *EventsPagedResponse) appendData(r *EventsPagedResponse) {
 // of the associated user.
 func (c *Client) ListEvents(ctx context.Context, opts *ListOptions) ([]Event, error) {
 	response := EventsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListEvents", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -211,6 +205,13 @@ func (c *Client) ListEvents(ctx context.Context, opts *ListOptions) ([]Event, er
 	return response.Data, nil
 }
 
+// ListEventsFunc calls fn with each Event, fetching pages as they are needed
+func (c *Client) ListEventsFunc(ctx context.Context, opts *ListOptions, fn func(Event) error) error {
+	return c.listFunc(ctx, "ListEventsFunc", &EventsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Event).fixDates())
+	})
+}
+
 // GetEvent gets the Event with the Event ID
 func (c *Client) GetEvent(ctx context.Context, id int) (*Event, error) {
 	e, err := c.Events.Endpoint()
@@ -218,7 +219,7 @@ func (c *Client) GetEvent(ctx context.Context, id int) (*Event, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := c.R(ctx).SetResult(&Event{}).Get(e)
+	r, err := coupleAPIErrors(c.request(ctx, "GetEvent").SetResult(&Event{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -228,56 +229,63 @@ func (c *Client) GetEvent(ctx context.Context, id int) (*Event, error) {
 // fixDates converts JSON timestamps to Go time.Time values
 func (e *Event) fixDates() *Event {
 	e.Created, _ = parseDates(e.CreatedStr)
-	e.TimeRemaining = unmarshalTimeRemaining(e.TimeRemainingMsg)
+	// An unexpected time_remaining is treated as unknown
+	e.TimeRemaining, _ = unmarshalTimeRemaining(e.TimeRemainingMsg)
 	return e
 }
 
 // MarkEventRead marks a single Event as read.
 func (c *Client) MarkEventRead(ctx context.Context, event *Event) error {
-	e := event.endpointWithID(c)
+	e, err := event.endpointWithID(c)
+	if err != nil {
+		return err
+	}
 	e = fmt.Sprintf("%s/read", e)
 
-	_, err := coupleAPIErrors(c.R(ctx).Post(e))
+	_, err = coupleAPIErrors(c.request(ctx, "MarkEventRead").Post(e))
 
 	return err
 }
 
 // MarkEventsSeen marks all Events up to and including this Event by ID as seen.
 func (c *Client) MarkEventsSeen(ctx context.Context, event *Event) error {
-	e := event.endpointWithID(c)
+	e, err := event.endpointWithID(c)
+	if err != nil {
+		return err
+	}
 	e = fmt.Sprintf("%s/seen", e)
 
-	_, err := coupleAPIErrors(c.R(ctx).Post(e))
+	_, err = coupleAPIErrors(c.request(ctx, "MarkEventsSeen").Post(e))
 
 	return err
 }
 
-func unmarshalTimeRemaining(m json.RawMessage) *int {
+// unmarshalTimeRemaining parses time_remaining, given either as seconds or in hh:mm:ss format
+func unmarshalTimeRemaining(m json.RawMessage) (*int, error) {
 	jsonBytes, err := m.MarshalJSON()
 	if err != nil {
-		panic(jsonBytes)
+		return nil, err
 	}
 
-	if len(jsonBytes) == 4 && string(jsonBytes) == "null" {
-		return nil
+	if len(jsonBytes) == 0 || (len(jsonBytes) == 4 && string(jsonBytes) == "null") {
+		return nil, nil
 	}
 
 	var timeStr string
 	if err := json.Unmarshal(jsonBytes, &timeStr); err == nil && len(timeStr) > 0 {
-		if dur, err := durationToSeconds(timeStr); err != nil {
-			panic(err)
-		} else {
-			return &dur
+		dur, err := durationToSeconds(timeStr)
+		if err != nil {
+			return nil, err
 		}
+		return &dur, nil
 	} else {
 		var intPtr int
 		if err := json.Unmarshal(jsonBytes, &intPtr); err == nil {
-			return &intPtr
+			return &intPtr, nil
 		}
 	}
 
-	log.Println("[WARN] Unexpected unmarshalTimeRemaining value: ", jsonBytes)
-	return nil
+	return nil, fmt.Errorf("unexpected time_remaining value %s", jsonBytes)
 }
 
 // durationToSeconds takes a hh:mm:ss string and returns the number of seconds
@@ -0,0 +1,255 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/linode/linodego/filter"
+)
+
+// WatchEventsOptions select the Events sent by WatchEvents
+type WatchEventsOptions struct {
+	// AfterID resumes a watch after the Event with this ID, such as the last Event
+	// received before a restart. Zero starts after the latest existing Event.
+	AfterID int
+
+	// EntityType, when set, only matches Events of entities of this type
+	EntityType EntityType
+
+	// EntityID, when set, only matches Events of the entity with this ID, which
+	// may be an int or a string
+	EntityID interface{}
+
+	// Actions, when set, only match Events with one of these actions
+	Actions []EventAction
+
+	// PollDelay is the delay between polls. It defaults to the Client's poll
+	// delay (see SetPollDelay).
+	PollDelay time.Duration
+
+	// OnError is called with the errors of failed polls. The watch goes on
+	// with the next poll.
+	OnError func(err error)
+}
+
+// eventWatch is the state of a WatchEvents call
+type eventWatch struct {
+	client *Client
+	opts   WatchEventsOptions
+
+	// conditions are the X-Filter conditions selecting the Events listed
+	conditions []*filter.Filter
+	// cursor is the ID of the latest Event listed
+	cursor int
+	// inProgress are the sent Events which have not yet finished or failed, by ID
+	inProgress map[int]Event
+}
+
+// WatchEvents returns a channel receiving the Events matching opts as they occur,
+// polling ListEvents with a cursor on the Event IDs, and GetEvent for each Event
+// still in progress. Each Event is sent once when it is first listed, then again
+// whenever its Status or PercentComplete changes. The channel is closed when ctx
+// is done.
+func (c *Client) WatchEvents(ctx context.Context, opts WatchEventsOptions) (<-chan Event, error) {
+	if opts.PollDelay <= 0 {
+		opts.PollDelay = c.pollDelay()
+	}
+
+	w := &eventWatch{
+		client:     c,
+		opts:       opts,
+		conditions: opts.filterConditions(),
+		cursor:     opts.AfterID,
+		inProgress: map[int]Event{},
+	}
+	if w.cursor == 0 {
+		latest, err := c.latestEventID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		w.cursor = latest
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		for {
+			if err := sleepContext(ctx, w.opts.PollDelay); err != nil {
+				return
+			}
+
+			updates, err := w.poll(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				c.logger().Warn("Error watching events", "error", err)
+				if w.opts.OnError != nil {
+					w.opts.OnError(err)
+				}
+				continue
+			}
+
+			for _, event := range updates {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
+
+// latestEventID returns the ID of the latest Event, or 0 if there are none
+func (c *Client) latestEventID(ctx context.Context) (int, error) {
+	xFilter, err := filter.Gt("id", 0).OrderBy("created", filter.Desc).Build()
+	if err != nil {
+		return 0, err
+	}
+	events, err := c.ListEvents(ctx, NewListOptions(1, xFilter))
+	if err != nil {
+		return 0, err
+	}
+
+	latest := 0
+	for _, event := range events {
+		if event.ID > latest {
+			latest = event.ID
+		}
+	}
+	return latest, nil
+}
+
+// filterConditions returns the X-Filter conditions selecting the Events matched
+// by the options. Entity IDs are only filtered by the API for some entity types
+// (see entityIDFilter), so matches checks the Events listed as well.
+func (o WatchEventsOptions) filterConditions() []*filter.Filter {
+	var conditions []*filter.Filter
+	if len(o.EntityType) > 0 {
+		conditions = append(conditions, filter.Eq("entity.type", o.EntityType))
+	}
+	if o.EntityID != nil {
+		if idFilter, err := entityIDFilter(o.EntityType, o.EntityID); err == nil && idFilter != nil {
+			conditions = append(conditions, idFilter)
+		}
+	}
+
+	switch len(o.Actions) {
+	case 0:
+	case 1:
+		conditions = append(conditions, filter.Eq("action", o.Actions[0]))
+	default:
+		actions := make([]*filter.Filter, len(o.Actions))
+		for i, action := range o.Actions {
+			actions[i] = filter.Eq("action", action)
+		}
+		conditions = append(conditions, filter.Or(actions...))
+	}
+	return conditions
+}
+
+// poll lists the Events after the cursor and gets the Events still in progress,
+// returning those to send in the order of their IDs
+func (w *eventWatch) poll(ctx context.Context) ([]Event, error) {
+	xFilter, err := filter.And(append([]*filter.Filter{filter.Gt("id", w.cursor)}, w.conditions...)...).Build()
+	if err != nil {
+		return nil, err
+	}
+	events, err := w.client.ListEvents(ctx, &ListOptions{Filter: xFilter})
+	if err != nil {
+		return nil, err
+	}
+
+	for id := range w.inProgress {
+		event, err := w.client.GetEvent(ctx, id)
+		if IsNotFound(err) {
+			// the Event is gone, so it will not change anymore
+			delete(w.inProgress, id)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+
+	var updates []Event
+	for _, event := range events {
+		if event.ID > w.cursor {
+			w.cursor = event.ID
+		} else if sent, ok := w.inProgress[event.ID]; !ok ||
+			(sent.Status == event.Status && sent.PercentComplete == event.PercentComplete) {
+			continue
+		}
+		if !w.matches(event) {
+			continue
+		}
+
+		updates = append(updates, event)
+		if event.Status == EventScheduled || event.Status == EventStarted {
+			w.inProgress[event.ID] = event
+		} else {
+			delete(w.inProgress, event.ID)
+		}
+	}
+	return updates, nil
+}
+
+// matches reports whether the Event is selected by the options of the watch
+func (w *eventWatch) matches(event Event) bool {
+	if len(w.opts.EntityType) > 0 || w.opts.EntityID != nil {
+		if event.Entity == nil {
+			return false
+		}
+		if len(w.opts.EntityType) > 0 && event.Entity.Type != w.opts.EntityType {
+			return false
+		}
+		if w.opts.EntityID != nil && formatEntityID(event.Entity.ID) != formatEntityID(w.opts.EntityID) {
+			return false
+		}
+	}
+
+	if len(w.opts.Actions) == 0 {
+		return true
+	}
+	for _, action := range w.opts.Actions {
+		if event.Action == action {
+			return true
+		}
+	}
+	return false
+}
+
+// formatEntityID formats the ID of an Event entity for comparison. Integer IDs
+// are decoded from JSON as float64 values.
+func formatEntityID(id interface{}) string {
+	switch id := id.(type) {
+	case float64, float32:
+		return fmt.Sprintf("%.f", id)
+	case int:
+		return strconv.Itoa(id)
+	default:
+		return fmt.Sprintf("%v", id)
+	}
+}
+
+// entityIDFilter returns the X-Filter condition selecting the Events of the
+// entity, or nil when the API does not filter the entity type by ID. All of the
+// filtered types have integer IDs.
+func entityIDFilter(entityType EntityType, id interface{}) (*filter.Filter, error) {
+	switch entityType {
+	case EntityDisk, EntityLinode, EntityDomain, EntityNodebalancer:
+	default:
+		return nil, nil
+	}
+	filterableID, err := strconv.Atoi(formatEntityID(id))
+	if err != nil {
+		return nil, err
+	}
+	return filter.Eq("entity.id", filterableID), nil
+}
@@ -0,0 +1,350 @@
+package linodego_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+// syntheticEvents serves a sequence of Events, which tests change as they run.
+// The filters of the requests are applied and the results are paged.
+type syntheticEvents struct {
+	mu      sync.Mutex
+	events  map[int]Event
+	perPage int
+	// pages are the pages listed so far
+	pages []int
+	// filters are the X-Filters of the lists so far
+	filters []string
+	// gets are the IDs of the Events got so far
+	gets []int
+}
+
+func newSyntheticEvents(perPage int, events ...Event) *syntheticEvents {
+	s := &syntheticEvents{events: map[int]Event{}, perPage: perPage}
+	s.set(events...)
+	return s
+}
+
+// set adds the events, replacing those with the same IDs
+func (s *syntheticEvents) set(events ...Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range events {
+		s.events[event.ID] = event
+	}
+}
+
+func (s *syntheticEvents) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/account/events/")); err == nil {
+		s.gets = append(s.gets, id)
+		event, ok := s.events[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(event)
+		return
+	}
+
+	xFilter := map[string]interface{}{}
+	if f := r.Header.Get("X-Filter"); len(f) > 0 {
+		s.filters = append(s.filters, f)
+		if err := json.Unmarshal([]byte(f), &xFilter); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	var events []Event
+	for _, event := range s.events {
+		if matchesXFilter(xFilter, event) {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if xFilter["+order"] == "desc" {
+			return events[i].ID > events[j].ID
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	s.pages = append(s.pages, page)
+	pages := (len(events) + s.perPage - 1) / s.perPage
+	if pages == 0 {
+		pages = 1
+	}
+	start, end := (page-1)*s.perPage, page*s.perPage
+	if start > len(events) {
+		start = len(events)
+	}
+	if end > len(events) {
+		end = len(events)
+	}
+
+	data, _ := json.Marshal(events[start:end])
+	fmt.Fprintf(w, `{"page": %d, "pages": %d, "results": %d, "data": %s}`, page, pages, len(events), data)
+}
+
+// matchesXFilter reports whether the Event matches the X-Filter expression, made
+// of conditions on the id, entity.type, entity.id and action fields
+func matchesXFilter(expr map[string]interface{}, event Event) bool {
+	for key, value := range expr {
+		switch key {
+		case "+and", "+or":
+			children, _ := value.([]interface{})
+			matches := 0
+			for _, child := range children {
+				if child, ok := child.(map[string]interface{}); ok && matchesXFilter(child, event) {
+					matches++
+				}
+			}
+			if (key == "+and" && matches < len(children)) || (key == "+or" && matches == 0) {
+				return false
+			}
+		case "id":
+			condition, _ := value.(map[string]interface{})
+			if gt, ok := condition["+gt"].(float64); ok && float64(event.ID) <= gt {
+				return false
+			}
+			if gte, ok := condition["+gte"].(float64); ok && float64(event.ID) < gte {
+				return false
+			}
+		case "entity.type":
+			if event.Entity == nil || value != string(event.Entity.Type) {
+				return false
+			}
+		case "entity.id":
+			if event.Entity == nil || fmt.Sprint(value) != fmt.Sprint(event.Entity.ID) {
+				return false
+			}
+		case "action":
+			if value != string(event.Action) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// syntheticEventCreated is the creation time of the synthetic Event with the ID
+func syntheticEventCreated(id int) time.Time {
+	return time.Date(2018, 1, 2, 3, 0, 0, 0, time.UTC).Add(time.Duration(id) * time.Minute)
+}
+
+// syntheticEvent returns an Event of the entity, created id minutes after 03:00
+func syntheticEvent(id int, entityType EntityType, entityID interface{}, action EventAction, status EventStatus, percent int) Event {
+	return Event{
+		ID:              id,
+		CreatedStr:      syntheticEventCreated(id).Format("2006-01-02T15:04:05"),
+		Action:          action,
+		Status:          status,
+		PercentComplete: percent,
+		Entity:          &EventEntity{ID: entityID, Type: entityType},
+	}
+}
+
+// receiveEvents receives n events, failing the test if they take too long
+func receiveEvents(t *testing.T, events <-chan Event, n int) []Event {
+	t.Helper()
+	var received []Event
+	for len(received) < n {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("Expected %d events, the channel was closed after %v", n, received)
+			}
+			received = append(received, event)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Expected %d events, got %v", n, received)
+		}
+	}
+	return received
+}
+
+func TestWatchEvents(t *testing.T) {
+	server := newSyntheticEvents(2, syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100))
+	client, teardown := createHTTPTestClient(t, server)
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := client.WatchEvents(ctx, WatchEventsOptions{
+		EntityType: EntityLinode,
+		EntityID:   123,
+		Actions:    []EventAction{ActionLinodeBoot, ActionLinodeReboot},
+		PollDelay:  5 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.set(
+		syntheticEvent(2, EntityLinode, 456, ActionLinodeBoot, EventStarted, 0),
+		syntheticEvent(3, EntityLinode, 123, ActionDiskCreate, EventStarted, 0),
+		syntheticEvent(4, EntityDomain, 123, ActionLinodeBoot, EventStarted, 0),
+		syntheticEvent(5, EntityLinode, 123, ActionLinodeBoot, EventStarted, 0),
+	)
+	received := receiveEvents(t, events, 1)
+
+	server.set(syntheticEvent(5, EntityLinode, 123, ActionLinodeBoot, EventStarted, 50))
+	received = append(received, receiveEvents(t, events, 1)...)
+
+	// unchanged events are not sent again
+	time.Sleep(50 * time.Millisecond)
+	server.set(
+		syntheticEvent(5, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+		syntheticEvent(6, EntityLinode, 123, ActionLinodeReboot, EventScheduled, 0),
+	)
+	received = append(received, receiveEvents(t, events, 2)...)
+
+	expected := []string{"5 started 0", "5 started 50", "5 finished 100", "6 scheduled 0"}
+	for i, event := range received {
+		if got := fmt.Sprintf("%d %s %d", event.ID, event.Status, event.PercentComplete); got != expected[i] {
+			t.Errorf("Expected event %d to be %q, got %q", i, expected[i], got)
+		}
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatchEvents_unfilteredEntityID(t *testing.T) {
+	server := newSyntheticEvents(2)
+	client, teardown := createHTTPTestClient(t, server)
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := client.WatchEvents(ctx, WatchEventsOptions{
+		EntityType: EntityVolume,
+		EntityID:   123,
+		PollDelay:  5 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.set(
+		syntheticEvent(1, EntityVolume, 456, ActionVolumeCreate, EventFinished, 100),
+		syntheticEvent(2, EntityVolume, 123, ActionVolumeCreate, EventFinished, 100),
+	)
+	if received := receiveEvents(t, events, 1); received[0].ID != 2 {
+		t.Errorf("Expected the event of volume 123, got %v", received)
+	}
+
+	cancel()
+	for range events {
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	for _, f := range server.filters {
+		if strings.Contains(f, "entity.id") {
+			t.Errorf("Expected volume IDs not to be filtered by the API, got %s", f)
+		}
+	}
+}
+
+func TestWatchEvents_afterID(t *testing.T) {
+	var sequence []Event
+	for id := 1; id <= 5; id++ {
+		sequence = append(sequence, syntheticEvent(id, EntityImage, fmt.Sprintf("private/%d", id), ActionImageDelete, EventFinished, 100))
+	}
+	server := newSyntheticEvents(2, sequence...)
+	client, teardown := createHTTPTestClient(t, server)
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := client.WatchEvents(ctx, WatchEventsOptions{AfterID: 2, EntityID: "private/4", PollDelay: 5 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.set(syntheticEvent(6, EntityImage, "private/4", ActionImageUpdate, EventNotification, 0))
+
+	received := receiveEvents(t, events, 2)
+	if received[0].ID != 4 || received[1].ID != 6 {
+		t.Errorf("Expected the events of private/4 after event 2, got %v", received)
+	}
+}
+
+func TestWatchEvents_longRunning(t *testing.T) {
+	server := newSyntheticEvents(2, syntheticEvent(1, EntityLinode, 123, ActionLinodeMigrate, EventFinished, 100))
+	client, teardown := createHTTPTestClient(t, server)
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := client.WatchEvents(ctx, WatchEventsOptions{
+		EntityType: EntityLinode,
+		EntityID:   123,
+		Actions:    []EventAction{ActionLinodeMigrate},
+		PollDelay:  5 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.set(syntheticEvent(2, EntityLinode, 123, ActionLinodeMigrate, EventStarted, 0))
+	receiveEvents(t, events, 1)
+
+	// other Events are not listed while the migration is in progress
+	for id := 3; id <= 12; id++ {
+		server.set(syntheticEvent(id, EntityLinode, 456, ActionLinodeBoot, EventFinished, 100))
+	}
+	time.Sleep(50 * time.Millisecond)
+	server.set(syntheticEvent(2, EntityLinode, 123, ActionLinodeMigrate, EventFinished, 100))
+	if received := receiveEvents(t, events, 1); received[0].ID != 2 || received[0].Status != EventFinished {
+		t.Errorf("Expected the migration to finish, got %v", received)
+	}
+
+	cancel()
+	for range events {
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	for _, f := range server.filters[1:] {
+		if !strings.Contains(f, `"entity.type":"linode"`) || !strings.Contains(f, `"entity.id":123`) ||
+			!strings.Contains(f, `"action":"linode_migrate"`) || strings.Contains(f, `"id":{"+gte"`) {
+			t.Errorf("Expected polls to filter the entity and action after the cursor, got %s", f)
+		}
+	}
+	for _, page := range server.pages {
+		if page != 1 {
+			t.Errorf("Expected polls to list a single page, got page %d", page)
+		}
+	}
+	if len(server.gets) == 0 {
+		t.Error("Expected the migration in progress to be polled with GetEvent")
+	}
+	for _, id := range server.gets {
+		if id != 2 {
+			t.Errorf("Expected only the migration in progress to be got, got %d", id)
+		}
+	}
+}
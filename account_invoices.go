@@ -36,24 +36,15 @@ type InvoicesPagedResponse struct {
 	Data []Invoice `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Invoice
-func (InvoicesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Invoices.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Invoices when processing paginated Invoice responses
-func (resp *InvoicesPagedResponse) appendData(r *InvoicesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *InvoicesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*InvoicesPagedResponse).Data...)
 }
 
 // ListInvoices gets a paginated list of Invoices against the Account
 func (c *Client) ListInvoices(ctx context.Context, opts *ListOptions) ([]Invoice, error) {
 	response := InvoicesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListInvoices", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -63,6 +54,13 @@ func (c *Client) ListInvoices(ctx context.Context, opts *ListOptions) ([]Invoice
 	return response.Data, nil
 }
 
+// ListInvoicesFunc calls fn with each Invoice, fetching pages as they are needed
+func (c *Client) ListInvoicesFunc(ctx context.Context, opts *ListOptions, fn func(Invoice) error) error {
+	return c.listFunc(ctx, "ListInvoicesFunc", &InvoicesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Invoice).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *Invoice) fixDates() *Invoice {
 	v.Date, _ = parseDates(v.DateStr)
@@ -84,7 +82,7 @@ func (c *Client) GetInvoice(ctx context.Context, id int) (*Invoice, error) {
 	}
 
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Invoice{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInvoice").SetResult(&Invoice{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -97,24 +95,15 @@ type InvoiceItemsPagedResponse struct {
 	Data []InvoiceItem `json:"data"`
 }
 
-// endpointWithID gets the endpoint URL for InvoiceItems associated with a specific Invoice
-func (InvoiceItemsPagedResponse) endpointWithID(c *Client, id int) string {
-	endpoint, err := c.InvoiceItems.endpointWithID(id)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends InvoiceItems when processing paginated Invoice Item responses
-func (resp *InvoiceItemsPagedResponse) appendData(r *InvoiceItemsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *InvoiceItemsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*InvoiceItemsPagedResponse).Data...)
 }
 
 // ListInvoiceItems gets the invoice items associated with a specific Invoice
 func (c *Client) ListInvoiceItems(ctx context.Context, id int, opts *ListOptions) ([]InvoiceItem, error) {
 	response := InvoiceItemsPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, id, opts)
+	err := c.listHelperWithID(ctx, "ListInvoiceItems", &response, id, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -123,3 +112,10 @@ func (c *Client) ListInvoiceItems(ctx context.Context, id int, opts *ListOptions
 	}
 	return response.Data, nil
 }
+
+// ListInvoiceItemsFunc calls fn with each InvoiceItem, fetching pages as they are needed
+func (c *Client) ListInvoiceItemsFunc(ctx context.Context, id int, opts *ListOptions, fn func(InvoiceItem) error) error {
+	return c.listFunc(ctx, "ListInvoiceItemsFunc", &InvoiceItemsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*InvoiceItem).fixDates())
+	}, id)
+}
@@ -62,18 +62,9 @@ type NotificationsPagedResponse struct {
 	Data []Notification `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Notification
-func (NotificationsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Notifications.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Notifications when processing paginated Notification responses
-func (resp *NotificationsPagedResponse) appendData(r *NotificationsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *NotificationsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*NotificationsPagedResponse).Data...)
 }
 
 // ListNotifications gets a collection of Notification objects representing important,
@@ -83,7 +74,7 @@ func (resp *NotificationsPagedResponse) appendData(r *NotificationsPagedResponse
 // to the Ticket will dismiss the Notification.
 func (c *Client) ListNotifications(ctx context.Context, opts *ListOptions) ([]Notification, error) {
 	response := NotificationsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListNotifications", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -93,6 +84,13 @@ func (c *Client) ListNotifications(ctx context.Context, opts *ListOptions) ([]No
 	return response.Data, nil
 }
 
+// ListNotificationsFunc calls fn with each Notification, fetching pages as they are needed
+func (c *Client) ListNotificationsFunc(ctx context.Context, opts *ListOptions, fn func(Notification) error) error {
+	return c.listFunc(ctx, "ListNotificationsFunc", &NotificationsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Notification).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *Notification) fixDates() *Notification {
 	v.Until, _ = parseDates(v.UntilStr)
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -31,7 +30,7 @@ type OAuthClient struct {
 	Status OAuthClientStatus `json:"status"`
 
 	// The OAuth Client secret, used in the OAuth exchange. This is returned as <REDACTED> except when an OAuth Client is created or its secret is reset. This is a secret, and should not be shared or disclosed publicly.
-	Secret string `json:"secret"`
+	Secret string `json:"secret" redact:"true"`
 
 	// If this OAuth Client is public or private.
 	Public bool `json:"public"`
@@ -86,30 +85,28 @@ type OAuthClientsPagedResponse struct {
 	Data []OAuthClient `json:"data"`
 }
 
-// endpoint gets the endpoint URL for OAuthClient
-func (OAuthClientsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.OAuthClients.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends OAuthClients when processing paginated OAuthClient responses
-func (resp *OAuthClientsPagedResponse) appendData(r *OAuthClientsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *OAuthClientsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*OAuthClientsPagedResponse).Data...)
 }
 
 // ListOAuthClients lists OAuthClients
 func (c *Client) ListOAuthClients(ctx context.Context, opts *ListOptions) ([]OAuthClient, error) {
 	response := OAuthClientsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListOAuthClients", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListOAuthClientsFunc calls fn with each OAuthClient, fetching pages as they are needed
+func (c *Client) ListOAuthClientsFunc(ctx context.Context, opts *ListOptions, fn func(OAuthClient) error) error {
+	return c.listFunc(ctx, "ListOAuthClientsFunc", &OAuthClientsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*OAuthClient))
+	})
+}
+
 // GetOAuthClient gets the OAuthClient with the provided ID
 func (c *Client) GetOAuthClient(ctx context.Context, id string) (*OAuthClient, error) {
 	e, err := c.OAuthClients.Endpoint()
@@ -117,7 +114,7 @@ func (c *Client) GetOAuthClient(ctx context.Context, id string) (*OAuthClient, e
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&OAuthClient{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetOAuthClient").SetResult(&OAuthClient{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -132,9 +129,9 @@ func (c *Client) CreateOAuthClient(ctx context.Context, createOpts OAuthClientCr
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&OAuthClient{})
+	req := c.request(ctx, "CreateOAuthClient").SetResult(&OAuthClient{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -159,9 +156,9 @@ func (c *Client) UpdateOAuthClient(ctx context.Context, id string, updateOpts OA
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	req := c.R(ctx).SetResult(&OAuthClient{})
+	req := c.request(ctx, "UpdateOAuthClient").SetResult(&OAuthClient{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -185,6 +182,6 @@ func (c *Client) DeleteOAuthClient(ctx context.Context, id string) error {
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteOAuthClient").Delete(e))
 	return err
 }
@@ -41,24 +41,15 @@ type PaymentsPagedResponse struct {
 	Data []Payment `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Payment
-func (PaymentsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Payments.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Payments when processing paginated Payment responses
-func (resp *PaymentsPagedResponse) appendData(r *PaymentsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *PaymentsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*PaymentsPagedResponse).Data...)
 }
 
 // ListPayments lists Payments
 func (c *Client) ListPayments(ctx context.Context, opts *ListOptions) ([]Payment, error) {
 	response := PaymentsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListPayments", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -68,6 +59,13 @@ func (c *Client) ListPayments(ctx context.Context, opts *ListOptions) ([]Payment
 	return response.Data, nil
 }
 
+// ListPaymentsFunc calls fn with each Payment, fetching pages as they are needed
+func (c *Client) ListPaymentsFunc(ctx context.Context, opts *ListOptions, fn func(Payment) error) error {
+	return c.listFunc(ctx, "ListPaymentsFunc", &PaymentsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Payment).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *Payment) fixDates() *Payment {
 	i.Date, _ = parseDates(i.DateStr)
@@ -81,7 +79,7 @@ func (c *Client) GetPayment(ctx context.Context, id int) (*Payment, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Payment{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetPayment").SetResult(&Payment{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +94,9 @@ func (c *Client) CreatePayment(ctx context.Context, createOpts PaymentCreateOpti
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Payment{})
+	req := c.request(ctx, "CreatePayment").SetResult(&Payment{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
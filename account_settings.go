@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 )
 
 // AccountSettings are the account wide flags or plans that effect new resources
@@ -38,7 +37,7 @@ func (c *Client) GetAccountSettings(ctx context.Context) (*AccountSettings, erro
 	if err != nil {
 		return nil, err
 	}
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&AccountSettings{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetAccountSettings").SetResult(&AccountSettings{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -53,9 +52,9 @@ func (c *Client) UpdateAccountSettings(ctx context.Context, settings AccountSett
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&AccountSettings{})
+	req := c.request(ctx, "UpdateAccountSettings").SetResult(&AccountSettings{})
 
-	if bodyData, err := json.Marshal(settings); err == nil {
+	if bodyData, err := c.marshalBody(ctx, settings); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
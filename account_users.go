@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -51,24 +50,15 @@ type UsersPagedResponse struct {
 	Data []User `json:"data"`
 }
 
-// endpoint gets the endpoint URL for User
-func (UsersPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Users.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Users when processing paginated User responses
-func (resp *UsersPagedResponse) appendData(r *UsersPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *UsersPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*UsersPagedResponse).Data...)
 }
 
 // ListUsers lists Users on the account
 func (c *Client) ListUsers(ctx context.Context, opts *ListOptions) ([]User, error) {
 	response := UsersPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListUsers", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -78,6 +68,13 @@ func (c *Client) ListUsers(ctx context.Context, opts *ListOptions) ([]User, erro
 	return response.Data, nil
 }
 
+// ListUsersFunc calls fn with each User, fetching pages as they are needed
+func (c *Client) ListUsersFunc(ctx context.Context, opts *ListOptions, fn func(User) error) error {
+	return c.listFunc(ctx, "ListUsersFunc", &UsersPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*User).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *User) fixDates() *User {
 	return i
@@ -90,7 +87,7 @@ func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&User{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetUser").SetResult(&User{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -106,9 +103,9 @@ func (c *Client) CreateUser(ctx context.Context, createOpts UserCreateOptions) (
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&User{})
+	req := c.request(ctx, "CreateUser").SetResult(&User{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -133,9 +130,9 @@ func (c *Client) UpdateUser(ctx context.Context, id string, updateOpts UserUpdat
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	req := c.R(ctx).SetResult(&User{})
+	req := c.request(ctx, "UpdateUser").SetResult(&User{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -159,6 +156,6 @@ func (c *Client) DeleteUser(ctx context.Context, id string) error {
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteUser").Delete(e))
 	return err
 }
@@ -0,0 +1,177 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// APIVersionBeta is the API version serving beta endpoints and fields
+const APIVersionBeta = "v4beta"
+
+// ErrBetaRequired is matched by errors.Is for calls to beta-only endpoints or
+// fields made with a non-beta API version
+var ErrBetaRequired = errors.New("beta API version required")
+
+// apiVersionPattern matches the version segment ending a base URL, such as v4 or v4beta
+var apiVersionPattern = regexp.MustCompile(`^v[0-9]+[a-z]*$`)
+
+type apiVersionKey struct{}
+
+// WithAPIVersion returns a context overriding the API version, such as
+// APIVersionBeta, of the requests made with it
+func WithAPIVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, apiVersionKey{}, version)
+}
+
+// SetAPIVersion sets the API version of all requests from this client, such as
+// APIVersionBeta, by replacing the version ending its base URL
+func (c *Client) SetAPIVersion(version string) *Client {
+	c.update(func(s *clientState) { s.baseURL = withAPIVersion(s.baseURL, version) })
+	return c
+}
+
+// GetAPIVersion returns the API version ending the client's base URL, or "" if
+// the base URL does not end with one
+func (c *Client) GetAPIVersion() string {
+	c.state.mu.RLock()
+	defer c.state.mu.RUnlock()
+	return apiVersionOf(c.state.baseURL)
+}
+
+// requestAPIVersion returns the API version of requests made with ctx to the base URL
+func requestAPIVersion(ctx context.Context, baseURL string) string {
+	if version, ok := ctx.Value(apiVersionKey{}).(string); ok && len(version) > 0 {
+		return version
+	}
+	return apiVersionOf(baseURL)
+}
+
+// apiVersionOf returns the version segment ending the URL, or ""
+func apiVersionOf(baseURL string) string {
+	segment := baseURL
+	if u, err := url.Parse(baseURL); err == nil {
+		segment = u.Path
+	}
+	segment = strings.TrimSuffix(segment, "/")
+	segment = segment[strings.LastIndex(segment, "/")+1:]
+	if apiVersionPattern.MatchString(segment) {
+		return segment
+	}
+	return ""
+}
+
+// withAPIVersion replaces the version segment ending the URL or path, or
+// appends one if it has none
+func withAPIVersion(baseURL string, version string) string {
+	trimmed := strings.TrimSuffix(baseURL, "/")
+	if len(apiVersionOf(trimmed)) > 0 {
+		trimmed = trimmed[:strings.LastIndex(trimmed, "/")]
+	}
+	return trimmed + "/" + version
+}
+
+// overrideAPIVersion rewrites the path of a request to use the API version in
+// ctx, if it differs from the version of the base URL
+func overrideAPIVersion(ctx context.Context, baseURL string, u *url.URL) {
+	version := requestAPIVersion(ctx, baseURL)
+	if version == apiVersionOf(baseURL) {
+		return
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return
+	}
+	basePath := strings.TrimSuffix(base.Path, "/")
+	if !strings.HasPrefix(u.Path, basePath) {
+		return
+	}
+	u.Path = withAPIVersion(basePath, version) + strings.TrimPrefix(u.Path, basePath)
+	u.RawPath = ""
+}
+
+// isBetaVersion reports whether the API version serves beta endpoints
+func isBetaVersion(version string) bool {
+	return strings.HasSuffix(version, "beta")
+}
+
+// checkBeta returns an error when a request made with a non-beta API version
+// uses a beta-only Resource, registered with newBetaResource. Requests to an
+// unrecognized base URL are not checked.
+func checkBeta(version string, resource *Resource) error {
+	if len(version) == 0 || isBetaVersion(version) || resource == nil || !resource.beta {
+		return nil
+	}
+	return betaRequiredError("The "+resource.name+" endpoint", version)
+}
+
+// checkBetaFields returns an error when the options of a request made with a
+// non-beta API version set beta-only fields. Beta-only fields are marked with
+// a `beta:"true"` tag:
+//
+//	type ExampleCreateOptions struct {
+//		Label   string `json:"label"`
+//		Feature bool   `json:"feature,omitempty" beta:"true"`
+//	}
+func checkBetaFields(version string, opts interface{}) error {
+	if len(version) == 0 || isBetaVersion(version) {
+		return nil
+	}
+	if fields := betaFieldsSet(opts); len(fields) > 0 {
+		return betaRequiredError("The "+strings.Join(fields, ", ")+" field", version)
+	}
+	return nil
+}
+
+// marshalBody marshals the options sent by a request made with ctx, after
+// checking that any beta-only fields they set are allowed by its API version
+func (c *Client) marshalBody(ctx context.Context, opts interface{}) ([]byte, error) {
+	c.state.mu.RLock()
+	baseURL := c.state.baseURL
+	c.state.mu.RUnlock()
+
+	if err := checkBetaFields(requestAPIVersion(ctx, baseURL), opts); err != nil {
+		return nil, err
+	}
+	return json.Marshal(opts)
+}
+
+func betaRequiredError(feature string, version string) *Error {
+	return NewError(fmt.Errorf("%s requires API version %s, but the request uses %s (see SetAPIVersion and WithAPIVersion): %w",
+		feature, APIVersionBeta, version, ErrBetaRequired))
+}
+
+// betaFieldsSet returns the JSON names of the beta-only fields set in the struct
+func betaFieldsSet(body interface{}) []string {
+	v := reflect.ValueOf(body)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []string
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get("beta") != "true" || v.Field(i).IsZero() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if len(name) == 0 {
+			name = field.Name
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
@@ -0,0 +1,146 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// apiVersionTestClient returns a Client of a server recording the paths requested
+func apiVersionTestClient(t *testing.T, paths *[]string) (*Client, func()) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*paths = append(*paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "versioned"}`))
+	}))
+
+	client := NewClient(nil)
+	client.SetBaseURL(server.URL + "/v4")
+	return &client, server.Close
+}
+
+func TestSetAPIVersion(t *testing.T) {
+	var paths []string
+	client, teardown := apiVersionTestClient(t, &paths)
+	defer teardown()
+
+	if client.GetAPIVersion() != APIVersion {
+		t.Errorf("Expected the client to use %s, got %q", APIVersion, client.GetAPIVersion())
+	}
+
+	client.SetAPIVersion(APIVersionBeta)
+	if client.GetAPIVersion() != APIVersionBeta {
+		t.Errorf("Expected the client to use %s, got %q", APIVersionBeta, client.GetAPIVersion())
+	}
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	// requests may override the client's version
+	if _, err := client.GetInstance(WithAPIVersion(context.Background(), "v4"), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"/v4beta/linode/instances/123", "/v4/linode/instances/123"}
+	if len(paths) != 2 || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("Expected requests to %v, got %v", expected, paths)
+	}
+}
+
+func TestWithAPIVersion(t *testing.T) {
+	var paths []string
+	client, teardown := apiVersionTestClient(t, &paths)
+	defer teardown()
+
+	if _, err := client.GetInstance(WithAPIVersion(context.Background(), APIVersionBeta), 123); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"/v4beta/linode/instances/123", "/v4/linode/instances/123"}
+	if len(paths) != 2 || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("Expected requests to %v, got %v", expected, paths)
+	}
+}
+
+type betaCreateOptions struct {
+	Label   string `json:"label"`
+	Feature bool   `json:"feature,omitempty" beta:"true"`
+}
+
+type betaThing struct {
+	ID int `json:"id"`
+}
+
+func TestCheckBeta_resource(t *testing.T) {
+	var paths []string
+	client, teardown := apiVersionTestClient(t, &paths)
+	defer teardown()
+	client.resources["betathings"] = newBetaResource(client, "betathings", "beta/things", false, betaThing{}, nil)
+
+	getThing := func(ctx context.Context) error {
+		_, err := coupleAPIErrors(client.R(ctx).SetResult(&betaThing{}).Get("beta/things/123"))
+		return err
+	}
+
+	if err := getThing(context.Background()); !errors.Is(err, ErrBetaRequired) {
+		t.Errorf("Expected a beta endpoint to require the beta API, got %v", err)
+	}
+	if err := getThing(WithAPIVersion(context.Background(), APIVersionBeta)); err != nil {
+		t.Errorf("Expected no error for a beta endpoint with the beta API, got %v", err)
+	}
+	client.SetAPIVersion(APIVersionBeta)
+	if err := getThing(context.Background()); err != nil {
+		t.Errorf("Expected no error for a beta endpoint with the beta API, got %v", err)
+	}
+
+	expected := []string{"/v4beta/beta/things/123", "/v4beta/beta/things/123"}
+	if len(paths) != 2 || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("Expected requests requiring the beta API not to be sent, got %v", paths)
+	}
+}
+
+func TestCheckBeta_fields(t *testing.T) {
+	var paths []string
+	client, teardown := apiVersionTestClient(t, &paths)
+	defer teardown()
+
+	// unset beta fields are allowed
+	if _, err := client.marshalBody(context.Background(), betaCreateOptions{Label: "v4"}); err != nil {
+		t.Errorf("Expected no error without beta fields, got %v", err)
+	}
+
+	_, err := client.marshalBody(context.Background(), betaCreateOptions{Label: "v4", Feature: true})
+	if !errors.Is(err, ErrBetaRequired) || !strings.Contains(err.Error(), "feature") {
+		t.Errorf("Expected a beta field to require the beta API, got %v", err)
+	}
+	if _, err := client.marshalBody(WithAPIVersion(context.Background(), APIVersionBeta), &betaCreateOptions{Feature: true}); err != nil {
+		t.Errorf("Expected no error for a beta field with the beta API, got %v", err)
+	}
+}
+
+func TestAPIVersionOf(t *testing.T) {
+	for url, version := range map[string]string{
+		"https://api.linode.com/v4":      "v4",
+		"https://api.linode.com/v4beta/": "v4beta",
+		"http://127.0.0.1:8080":          "",
+		"https://proxy.example.com/api":  "",
+	} {
+		if v := apiVersionOf(url); v != version {
+			t.Errorf("Expected %s to have version %q, got %q", url, version, v)
+		}
+	}
+
+	if u := withAPIVersion("https://api.linode.com/v4/", APIVersionBeta); u != "https://api.linode.com/v4beta" {
+		t.Errorf("Expected the version to be replaced, got %s", u)
+	}
+	if u := withAPIVersion("http://127.0.0.1:8080", "v4"); u != "http://127.0.0.1:8080/v4" {
+		t.Errorf("Expected the version to be appended, got %s", u)
+	}
+}
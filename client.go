@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"gopkg.in/resty.v1"
@@ -22,6 +22,8 @@ const (
 	APIHostCert = "LINODE_CA"
 	// APIVersion Linode API version
 	APIVersion = "v4"
+	// APIVersionVar environment var to check for an alternate API version
+	APIVersionVar = "LINODE_API_VERSION"
 	// APIProto connect to API with http(s)
 	APIProto = "https"
 	// Version of linodego
@@ -36,16 +38,21 @@ const (
 
 var (
 	envDebug = false
+	// envDebugInvalid is the unparsable value of LINODE_DEBUG, if any
+	envDebugInvalid = ""
 )
 
-// Client is a wrapper around the Resty client
+// Client is a wrapper around the Resty client.
+//
+// A Client is safe for concurrent use by multiple goroutines, including while
+// its settings, such as its token or poll delay, are changed. A request uses
+// the settings in effect when it is made, and later changes apply to the
+// requests made after them. Copies of a Client, such as those returned by
+// NewClient, share its settings and Resources.
 type Client struct {
-	resty     *resty.Client
-	userAgent string
+	state     *clientState
 	resources map[string]*Resource
-	debug     bool
-
-	millisecondsPerPoll time.Duration
+	transport *apiTransport
 
 	Images                *Resource
 	InstanceDisks         *Resource
@@ -85,153 +92,325 @@ type Client struct {
 	Profile               *Resource
 	Managed               *Resource
 	Tags                  *Resource
+	TaggedObjects         *Resource
 	Users                 *Resource
 	Payments              *Resource
 }
 
+// clientState holds the settings of a Client that may change after it is
+// created. It is shared by copies of the Client and guarded by mu.
+type clientState struct {
+	mu sync.RWMutex
+
+	// resty is replaced, rather than modified, whenever the settings below
+	// change, so that requests in flight keep the settings they started with
+	resty      *resty.Client
+	httpClient http.Client
+	userAgent  string
+	token      string
+	baseURL    string
+	debug      bool
+
+	// the settings below are not used by resty
+	millisecondsPerPoll time.Duration
+	pageConcurrency     int
+}
+
+// Option configures a Client created by New
+type Option func(o *options)
+
+// options are the settings of a Client being created by New, before its resty
+// client is built
+type options struct {
+	state      *clientState
+	transport  *transportSettings
+	httpClient *http.Client
+}
+
+// WithHTTPClient sets the http.Client sending the requests of the Client, such as
+// one configured with an oauth2 token source. The http.Client is copied, so it
+// is not affected by the Client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *options) { o.httpClient = hc }
+}
+
+// WithToken sets the API token of the Client (see SetToken)
+func WithToken(token string) Option {
+	return func(o *options) { o.state.token = token }
+}
+
+// WithBaseURL sets the base URL of the Client (see SetBaseURL)
+func WithBaseURL(url string) Option {
+	return func(o *options) { o.state.baseURL = url }
+}
+
+// WithUserAgent sets the User-Agent of the Client (see SetUserAgent)
+func WithUserAgent(ua string) Option {
+	return func(o *options) { o.state.userAgent = ua }
+}
+
+// WithDebug enables or disables the debug output of the Client (see SetDebug)
+func WithDebug(debug bool) Option {
+	return func(o *options) {
+		o.state.debug = debug
+		o.transport.debug = debug
+	}
+}
+
+// WithLogger sets the Logger of the Client (see SetLogger)
+func WithLogger(logger Logger) Option {
+	return func(o *options) { o.transport.logger = logger }
+}
+
+// WithPollDelay sets the poll delay of the Client's WaitFor functions (see SetPollDelay)
+func WithPollDelay(delay time.Duration) Option {
+	return func(o *options) { o.state.millisecondsPerPoll = delay }
+}
+
+// WithRetryPolicy sets the retry policy of the Client (see SetRetryPolicy)
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) { o.transport.retryPolicy = policy }
+}
+
 func init() {
 	// Wether or not we will enable Resty debugging output
 	if apiDebug, ok := os.LookupEnv("LINODE_DEBUG"); ok {
 		if parsed, err := strconv.ParseBool(apiDebug); err == nil {
 			envDebug = parsed
-			log.Println("[INFO] LINODE_DEBUG being set to", envDebug)
 		} else {
-			log.Println("[WARN] LINODE_DEBUG should be an integer, 0 or 1")
+			envDebugInvalid = apiDebug
 		}
 	}
 
 }
 
+// update changes the settings of the Client with fn, then replaces the resty
+// client used by later requests
+func (c *Client) update(fn func(s *clientState)) {
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	fn(c.state)
+	c.state.resty = c.newResty()
+}
+
+// updateState changes the settings of the Client not used by resty with fn
+func (c *Client) updateState(fn func(s *clientState)) {
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	fn(c.state)
+}
+
+// newResty returns a resty client using the settings of the Client, which must
+// be locked
+func (c *Client) newResty() *resty.Client {
+	s := c.state
+
+	// resty sets the redirect policy of its http.Client, so each gets a copy
+	httpClient := s.httpClient
+	rc := resty.NewWithClient(&httpClient).
+		SetLogger(restyLogWriter{c.transport}).
+		SetLogPrefix("").
+		SetDebug(s.debug).
+		SetHostURL(s.baseURL).
+		SetHeader("User-Agent", s.userAgent).
+		SetPreRequestHook(c.transport.beforeRequestHook(c.resources)).
+		OnAfterResponse(c.transport.afterResponseHook)
+	if len(s.token) > 0 {
+		rc.SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.token))
+	}
+	return rc
+}
+
+// newHTTPClient returns a copy of hc, or a default http.Client when hc is nil,
+// sending its requests through the transport
+func newHTTPClient(hc *http.Client, transport *apiTransport) http.Client {
+	// Copy the http.Client so that wrapping its transport does not affect the caller
+	var httpClient http.Client
+	if hc != nil {
+		httpClient = *hc
+	} else {
+		httpClient = *resty.New().GetClient()
+	}
+	transport.settings.base = httpClient.Transport
+	httpClient.Transport = transport
+	return httpClient
+}
+
 // SetUserAgent sets a custom user-agent for HTTP requests
 func (c *Client) SetUserAgent(ua string) *Client {
-	c.userAgent = ua
-	c.resty.SetHeader("User-Agent", c.userAgent)
-
+	c.update(func(s *clientState) { s.userAgent = ua })
 	return c
 }
 
 // R wraps resty's R method
 func (c *Client) R(ctx context.Context) *resty.Request {
-	return c.resty.R().
+	c.state.mu.RLock()
+	rc := c.state.resty
+	c.state.mu.RUnlock()
+
+	return rc.R().
 		ExpectContentType("application/json").
 		SetHeader("Content-Type", "application/json").
 		SetContext(ctx).
 		SetError(APIError{})
 }
 
-// SetDebug sets the debug on resty's client
+// request returns a request made by the named Client method, such as
+// "CreateInstance", whose span is named after it
+func (c *Client) request(ctx context.Context, operation string) *resty.Request {
+	return c.R(withOperation(ctx, operation))
+}
+
+// SetDebug sets the debug on resty's client. The requests and responses are
+// logged at the debug level of the Client's Logger (see SetLogger).
 func (c *Client) SetDebug(debug bool) *Client {
-	c.debug = debug
-	c.resty.SetDebug(debug)
+	c.transport.update(func(s *transportSettings) { s.debug = debug })
+	c.update(func(s *clientState) { s.debug = debug })
 	return c
 }
 
-// SetBaseURL sets the base URL of the Linode v4 API (https://api.linode.com/v4)
+// SetBaseURL sets the base URL of the Linode v4 API (https://api.linode.com/v4).
+// The API version is the final segment of the URL (see SetAPIVersion).
 func (c *Client) SetBaseURL(url string) *Client {
-	c.resty.SetHostURL(url)
+	c.update(func(s *clientState) { s.baseURL = url })
 	return c
 }
 
+// SetRootCertificate adds the PEM encoded certificate at path to the root
+// certificates trusted by the underlying transport, which must be an
+// *http.Transport. If the certificate cannot be added, the requests of the
+// Client fail with the error rather than trusting the system roots alone.
 func (c *Client) SetRootCertificate(path string) *Client {
-	c.resty.SetRootCertificate(path)
+	if err := c.addRootCertificate(path); err != nil {
+		c.logger().Error("Error adding root certificate", "path", path, "error", err)
+	}
 	return c
 }
 
+// addRootCertificate adds the PEM encoded certificate at path to the root
+// certificates trusted by the transport. On failure, the error is also returned
+// by the requests of the Client.
+func (c *Client) addRootCertificate(path string) error {
+	cert, err := ioutil.ReadFile(path)
+	if err == nil {
+		err = c.transport.addRootCertificate(cert)
+	}
+	if err != nil {
+		err = fmt.Errorf("Error adding root certificate %s: %w", path, err)
+		c.transport.update(func(s *transportSettings) { s.certErr = err })
+		return err
+	}
+	c.logger().Debug("Set API root certificate", "path", path, "contents", string(cert))
+	return nil
+}
+
 // SetToken sets the API token for all requests from this client
 // Only necessary if you haven't already provided an http client to NewClient() configured with the token.
 func (c *Client) SetToken(token string) *Client {
-	c.resty.SetHeader("Authorization", fmt.Sprintf("Bearer %s", token))
+	c.update(func(s *clientState) { s.token = token })
 	return c
 }
 
 // SetPollDelay sets the number of milliseconds to wait between events or status polls.
 // Affects all WaitFor* functions.
 func (c *Client) SetPollDelay(delay time.Duration) *Client {
-	c.millisecondsPerPoll = delay
+	c.updateState(func(s *clientState) { s.millisecondsPerPoll = delay })
 	return c
 }
 
-// Resource looks up a resource by name
-func (c Client) Resource(resourceName string) *Resource {
+// pollDelay returns the delay between the polls of the WaitFor functions
+func (c *Client) pollDelay() time.Duration {
+	c.state.mu.RLock()
+	defer c.state.mu.RUnlock()
+	return c.state.millisecondsPerPoll * time.Millisecond
+}
+
+// SetPageConcurrency sets the number of pages fetched concurrently when List
+// methods retrieve all pages, after the first page reveals how many pages there
+// are. Results are still returned in page order. The default of 1 fetches pages
+// one at a time.
+func (c *Client) SetPageConcurrency(workers int) *Client {
+	c.updateState(func(s *clientState) { s.pageConcurrency = workers })
+	return c
+}
+
+// pageWorkers returns the number of pages fetched concurrently by List methods
+func (c *Client) pageWorkers() int {
+	c.state.mu.RLock()
+	defer c.state.mu.RUnlock()
+	return c.state.pageConcurrency
+}
+
+// Resource looks up a resource by name, returning nil if there is none (see LookupResource)
+func (c *Client) Resource(resourceName string) *Resource {
+	return c.resources[resourceName]
+}
+
+// LookupResource looks up a resource by name, returning an error if there is none
+func (c *Client) LookupResource(resourceName string) (*Resource, error) {
 	selectedResource, ok := c.resources[resourceName]
 	if !ok {
-		log.Fatalf("Could not find resource named '%s', exiting.", resourceName)
+		return nil, NewError(fmt.Sprintf("Could not find resource named '%s'", resourceName))
 	}
-	return selectedResource
+	return selectedResource, nil
 }
 
-// NewClient factory to create new Client struct
-func NewClient(hc *http.Client) (client Client) {
-	if hc != nil {
-		client.resty = resty.NewWithClient(hc)
-	} else {
-		client.resty = resty.New()
-	}
-	client.SetUserAgent(DefaultUserAgent)
-	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
-	if baseURLExists {
-		client.SetBaseURL(baseURL)
-	} else {
-		client.SetBaseURL(fmt.Sprintf("%s://%s/%s", APIProto, APIHost, APIVersion))
-	}
-	certPath, certPathExists := os.LookupEnv(APIHostCert)
-	if certPathExists {
-		cert, err := ioutil.ReadFile(certPath)
-		if err != nil {
-			log.Fatalf("[ERROR] Error when reading cert at %s: %s\n", certPath, err.Error())
-		}
-		client.SetRootCertificate(certPath)
-		if envDebug {
-			log.Printf("[DEBUG] Set API root certificate to %s with contents %s\n", certPath, cert)
-		}
-	}
-	client.SetPollDelay(1000 * APISecondsPerPoll)
+// NewClient factory to create new Client struct. The Client sends its requests
+// with hc, or with a default http.Client when hc is nil.
+func NewClient(hc *http.Client) Client {
+	return *New(WithHTTPClient(hc))
+}
+
+// New returns a Client configured by the environment, such as LINODE_URL and
+// LINODE_DEBUG, and then by opts. The Client's Resources refer back to it.
+func New(opts ...Option) *Client {
+	client := &Client{transport: newAPITransport(nil)}
 
 	resources := map[string]*Resource{
-		stackscriptsName:          NewResource(&client, stackscriptsName, stackscriptsEndpoint, false, Stackscript{}, StackscriptsPagedResponse{}),
-		imagesName:                NewResource(&client, imagesName, imagesEndpoint, false, Image{}, ImagesPagedResponse{}),
-		instancesName:             NewResource(&client, instancesName, instancesEndpoint, false, Instance{}, InstancesPagedResponse{}),
-		instanceDisksName:         NewResource(&client, instanceDisksName, instanceDisksEndpoint, true, InstanceDisk{}, InstanceDisksPagedResponse{}),
-		instanceConfigsName:       NewResource(&client, instanceConfigsName, instanceConfigsEndpoint, true, InstanceConfig{}, InstanceConfigsPagedResponse{}),
-		instanceSnapshotsName:     NewResource(&client, instanceSnapshotsName, instanceSnapshotsEndpoint, true, InstanceSnapshot{}, nil),
-		instanceIPsName:           NewResource(&client, instanceIPsName, instanceIPsEndpoint, true, InstanceIP{}, nil),                           // really?
-		instanceVolumesName:       NewResource(&client, instanceVolumesName, instanceVolumesEndpoint, true, nil, InstanceVolumesPagedResponse{}), // really?
-		instanceStatsName:         NewResource(&client, instanceStatsName, instanceStatsEndpoint, true, InstanceStats{}, nil),
-		ipaddressesName:           NewResource(&client, ipaddressesName, ipaddressesEndpoint, false, nil, IPAddressesPagedResponse{}), // really?
-		ipv6poolsName:             NewResource(&client, ipv6poolsName, ipv6poolsEndpoint, false, nil, IPv6PoolsPagedResponse{}),       // really?
-		ipv6rangesName:            NewResource(&client, ipv6rangesName, ipv6rangesEndpoint, false, IPv6Range{}, IPv6RangesPagedResponse{}),
-		regionsName:               NewResource(&client, regionsName, regionsEndpoint, false, Region{}, RegionsPagedResponse{}),
-		volumesName:               NewResource(&client, volumesName, volumesEndpoint, false, Volume{}, VolumesPagedResponse{}),
-		kernelsName:               NewResource(&client, kernelsName, kernelsEndpoint, false, LinodeKernel{}, LinodeKernelsPagedResponse{}),
-		typesName:                 NewResource(&client, typesName, typesEndpoint, false, LinodeType{}, LinodeTypesPagedResponse{}),
-		domainsName:               NewResource(&client, domainsName, domainsEndpoint, false, Domain{}, DomainsPagedResponse{}),
-		domainRecordsName:         NewResource(&client, domainRecordsName, domainRecordsEndpoint, true, DomainRecord{}, DomainRecordsPagedResponse{}),
-		longviewName:              NewResource(&client, longviewName, longviewEndpoint, false, nil, nil), // really?
-		longviewclientsName:       NewResource(&client, longviewclientsName, longviewclientsEndpoint, false, LongviewClient{}, LongviewClientsPagedResponse{}),
-		longviewsubscriptionsName: NewResource(&client, longviewsubscriptionsName, longviewsubscriptionsEndpoint, false, LongviewSubscription{}, LongviewSubscriptionsPagedResponse{}),
-		nodebalancersName:         NewResource(&client, nodebalancersName, nodebalancersEndpoint, false, NodeBalancer{}, NodeBalancerConfigsPagedResponse{}),
-		nodebalancerconfigsName:   NewResource(&client, nodebalancerconfigsName, nodebalancerconfigsEndpoint, true, NodeBalancerConfig{}, NodeBalancerConfigsPagedResponse{}),
-		nodebalancernodesName:     NewResource(&client, nodebalancernodesName, nodebalancernodesEndpoint, true, NodeBalancerNode{}, NodeBalancerNodesPagedResponse{}),
-		notificationsName:         NewResource(&client, notificationsName, notificationsEndpoint, false, Notification{}, NotificationsPagedResponse{}),
-		oauthClientsName:          NewResource(&client, oauthClientsName, oauthClientsEndpoint, false, OAuthClient{}, OAuthClientsPagedResponse{}),
-		sshkeysName:               NewResource(&client, sshkeysName, sshkeysEndpoint, false, SSHKey{}, SSHKeysPagedResponse{}),
-		ticketsName:               NewResource(&client, ticketsName, ticketsEndpoint, false, Ticket{}, TicketsPagedResponse{}),
-		tokensName:                NewResource(&client, tokensName, tokensEndpoint, false, Token{}, TokensPagedResponse{}),
-		accountName:               NewResource(&client, accountName, accountEndpoint, false, Account{}, nil),                         // really?
-		accountSettingsName:       NewResource(&client, accountSettingsName, accountSettingsEndpoint, false, AccountSettings{}, nil), // really?
-		eventsName:                NewResource(&client, eventsName, eventsEndpoint, false, Event{}, EventsPagedResponse{}),
-		invoicesName:              NewResource(&client, invoicesName, invoicesEndpoint, false, Invoice{}, InvoicesPagedResponse{}),
-		invoiceItemsName:          NewResource(&client, invoiceItemsName, invoiceItemsEndpoint, true, InvoiceItem{}, InvoiceItemsPagedResponse{}),
-		profileName:               NewResource(&client, profileName, profileEndpoint, false, nil, nil), // really?
-		managedName:               NewResource(&client, managedName, managedEndpoint, false, nil, nil), // really?
-		tagsName:                  NewResource(&client, tagsName, tagsEndpoint, false, Tag{}, TagsPagedResponse{}),
-		usersName:                 NewResource(&client, usersName, usersEndpoint, false, User{}, UsersPagedResponse{}),
-		paymentsName:              NewResource(&client, paymentsName, paymentsEndpoint, false, Payment{}, PaymentsPagedResponse{}),
+		stackscriptsName:          NewResource(client, stackscriptsName, stackscriptsEndpoint, false, Stackscript{}, StackscriptsPagedResponse{}),
+		imagesName:                NewResource(client, imagesName, imagesEndpoint, false, Image{}, ImagesPagedResponse{}),
+		instancesName:             NewResource(client, instancesName, instancesEndpoint, false, Instance{}, InstancesPagedResponse{}),
+		instanceDisksName:         NewResource(client, instanceDisksName, instanceDisksEndpoint, true, InstanceDisk{}, InstanceDisksPagedResponse{}),
+		instanceConfigsName:       NewResource(client, instanceConfigsName, instanceConfigsEndpoint, true, InstanceConfig{}, InstanceConfigsPagedResponse{}),
+		instanceSnapshotsName:     NewResource(client, instanceSnapshotsName, instanceSnapshotsEndpoint, true, InstanceSnapshot{}, nil),
+		instanceIPsName:           NewResource(client, instanceIPsName, instanceIPsEndpoint, true, InstanceIP{}, nil),                           // really?
+		instanceVolumesName:       NewResource(client, instanceVolumesName, instanceVolumesEndpoint, true, nil, InstanceVolumesPagedResponse{}), // really?
+		instanceStatsName:         NewResource(client, instanceStatsName, instanceStatsEndpoint, true, InstanceStats{}, nil),
+		ipaddressesName:           NewResource(client, ipaddressesName, ipaddressesEndpoint, false, nil, IPAddressesPagedResponse{}), // really?
+		ipv6poolsName:             NewResource(client, ipv6poolsName, ipv6poolsEndpoint, false, nil, IPv6PoolsPagedResponse{}),       // really?
+		ipv6rangesName:            NewResource(client, ipv6rangesName, ipv6rangesEndpoint, false, IPv6Range{}, IPv6RangesPagedResponse{}),
+		regionsName:               NewResource(client, regionsName, regionsEndpoint, false, Region{}, RegionsPagedResponse{}),
+		volumesName:               NewResource(client, volumesName, volumesEndpoint, false, Volume{}, VolumesPagedResponse{}),
+		kernelsName:               NewResource(client, kernelsName, kernelsEndpoint, false, LinodeKernel{}, LinodeKernelsPagedResponse{}),
+		typesName:                 NewResource(client, typesName, typesEndpoint, false, LinodeType{}, LinodeTypesPagedResponse{}),
+		domainsName:               NewResource(client, domainsName, domainsEndpoint, false, Domain{}, DomainsPagedResponse{}),
+		domainRecordsName:         NewResource(client, domainRecordsName, domainRecordsEndpoint, true, DomainRecord{}, DomainRecordsPagedResponse{}),
+		longviewName:              NewResource(client, longviewName, longviewEndpoint, false, nil, nil), // really?
+		longviewclientsName:       NewResource(client, longviewclientsName, longviewclientsEndpoint, false, LongviewClient{}, LongviewClientsPagedResponse{}),
+		longviewsubscriptionsName: NewResource(client, longviewsubscriptionsName, longviewsubscriptionsEndpoint, false, LongviewSubscription{}, LongviewSubscriptionsPagedResponse{}),
+		nodebalancersName:         NewResource(client, nodebalancersName, nodebalancersEndpoint, false, NodeBalancer{}, NodeBalancersPagedResponse{}),
+		nodebalancerconfigsName:   NewResource(client, nodebalancerconfigsName, nodebalancerconfigsEndpoint, true, NodeBalancerConfig{}, NodeBalancerConfigsPagedResponse{}),
+		nodebalancernodesName:     NewResource(client, nodebalancernodesName, nodebalancernodesEndpoint, true, NodeBalancerNode{}, NodeBalancerNodesPagedResponse{}),
+		notificationsName:         NewResource(client, notificationsName, notificationsEndpoint, false, Notification{}, NotificationsPagedResponse{}),
+		oauthClientsName:          NewResource(client, oauthClientsName, oauthClientsEndpoint, false, OAuthClient{}, OAuthClientsPagedResponse{}),
+		sshkeysName:               NewResource(client, sshkeysName, sshkeysEndpoint, false, SSHKey{}, SSHKeysPagedResponse{}),
+		ticketsName:               NewResource(client, ticketsName, ticketsEndpoint, false, Ticket{}, TicketsPagedResponse{}),
+		tokensName:                NewResource(client, tokensName, tokensEndpoint, false, Token{}, TokensPagedResponse{}),
+		accountName:               NewResource(client, accountName, accountEndpoint, false, Account{}, nil),                         // really?
+		accountSettingsName:       NewResource(client, accountSettingsName, accountSettingsEndpoint, false, AccountSettings{}, nil), // really?
+		eventsName:                NewResource(client, eventsName, eventsEndpoint, false, Event{}, EventsPagedResponse{}),
+		invoicesName:              NewResource(client, invoicesName, invoicesEndpoint, false, Invoice{}, InvoicesPagedResponse{}),
+		invoiceItemsName:          NewResource(client, invoiceItemsName, invoiceItemsEndpoint, true, InvoiceItem{}, InvoiceItemsPagedResponse{}),
+		profileName:               NewResource(client, profileName, profileEndpoint, false, nil, nil), // really?
+		managedName:               NewResource(client, managedName, managedEndpoint, false, nil, nil), // really?
+		tagsName:                  NewResource(client, tagsName, tagsEndpoint, false, Tag{}, TagsPagedResponse{}),
+		taggedObjectsName:         NewResource(client, taggedObjectsName, taggedObjectsEndpoint, true, TaggedObject{}, TaggedObjectsPagedResponse{}),
+		usersName:                 NewResource(client, usersName, usersEndpoint, false, User{}, UsersPagedResponse{}),
+		paymentsName:              NewResource(client, paymentsName, paymentsEndpoint, false, Payment{}, PaymentsPagedResponse{}),
 	}
 
 	client.resources = resources
 
-	client.SetDebug(envDebug)
 	client.Images = resources[imagesName]
 	client.StackScripts = resources[stackscriptsName]
 	client.Instances = resources[instancesName]
@@ -266,9 +445,50 @@ func NewClient(hc *http.Client) (client Client) {
 	client.Profile = resources[profileName]
 	client.Managed = resources[managedName]
 	client.Tags = resources[tagsName]
+	client.TaggedObjects = resources[taggedObjectsName]
 	client.Users = resources[usersName]
 	client.Payments = resources[paymentsName]
-	return
+
+	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
+	if !baseURLExists {
+		baseURL = fmt.Sprintf("%s://%s/%s", APIProto, APIHost, APIVersion)
+		if version := os.Getenv(APIVersionVar); len(version) > 0 {
+			baseURL = withAPIVersion(baseURL, version)
+		}
+	}
+	o := &options{
+		state: &clientState{
+			userAgent:           DefaultUserAgent,
+			baseURL:             baseURL,
+			debug:               envDebug,
+			millisecondsPerPoll: 1000 * APISecondsPerPoll,
+		},
+		transport: &transportSettings{debug: envDebug},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// The resty client is built once, with the settings of the options
+	client.transport.settings = *o.transport
+	o.state.httpClient = newHTTPClient(o.httpClient, client.transport)
+	client.state = o.state
+	client.state.resty = client.newResty()
+
+	if len(envDebugInvalid) > 0 {
+		// The warning is printed even when no Logger is set and debugging is off
+		logger := client.logger()
+		if _, ok := logger.(noopLogger); ok {
+			logger = stdLogger{}
+		}
+		logger.Warn("LINODE_DEBUG should be a boolean, such as 0 or 1", "value", envDebugInvalid)
+	}
+
+	// The certificate is added to the transport of the http.Client given by the options
+	if certPath, certPathExists := os.LookupEnv(APIHostCert); certPathExists {
+		client.SetRootCertificate(certPath)
+	}
+	return client
 }
 
 func copyBool(bPtr *bool) *bool {
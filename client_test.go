@@ -1,10 +1,15 @@
 package linodego_test
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -105,6 +110,20 @@ func createTestClient(t *testing.T, fixturesYaml string) (*Client, func()) {
 	return &c, recordStopper
 }
 
+// createHTTPTestClient is a testing helper that creates a linodego.Client whose
+// requests are served by handler. The returned function should be deferred by
+// the caller to shut down the test server.
+func createHTTPTestClient(t *testing.T, handler http.Handler) (*Client, func()) {
+	if t != nil {
+		t.Helper()
+	}
+
+	server := httptest.NewServer(handler)
+
+	c := New(WithBaseURL(server.URL), WithDebug(debugAPI), WithPollDelay(testingPollDuration))
+	return c, server.Close
+}
+
 func TestClientAliases(t *testing.T) {
 	client, _ := createTestClient(t, "")
 
@@ -127,3 +146,104 @@ func TestClientAliases(t *testing.T) {
 		t.Error("Expected alias for Volumes to return a *Resource")
 	}
 }
+
+func TestNew(t *testing.T) {
+	var mu sync.Mutex
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"page": 1, "pages": 1, "results": 1, "data": [{"id": "g6-nanode-1"}]}`))
+	}))
+	defer server.Close()
+
+	client := New(WithBaseURL(server.URL), WithToken("first"), WithUserAgent("test-agent"))
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Resources and copies of the Client see settings changed afterwards
+	clientCopy := *client
+	clientCopy.SetToken("second")
+	if _, err := client.Types.PR(context.Background()).Get(server.URL + "/linode/types"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Bearer first", "Bearer second", "Bearer second"}
+	if fmt.Sprint(tokens) != fmt.Sprint(expected) {
+		t.Errorf("Expected the tokens %v, got %v", expected, tokens)
+	}
+}
+
+// TestClient_concurrentSettings shares a Client between goroutines making requests
+// and goroutines changing its settings. Run it with -race.
+func TestClient_concurrentSettings(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, typesHandler())
+	defer teardown()
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := client.ListTypes(ctx, nil); err != nil {
+					errs <- err
+				}
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				client.SetToken(fmt.Sprintf("token-%d-%d", i, j)).
+					SetUserAgent(fmt.Sprintf("agent-%d-%d", i, j)).
+					SetPollDelay(time.Duration(j)).
+					SetPageConcurrency(j%3 + 1).
+					SetLogger(&testLogger{}).
+					SetDebug(j%2 == 0).
+					SetRetryPolicy(testRetryPolicy()).
+					SetAPIVersion(APIVersion).
+					OnAfterResponse(func(context.Context, *ResponseInfo) {})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestNew_invalidCertificate(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		typesHandler().ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	if value, ok := os.LookupEnv(APIHostCert); ok {
+		defer os.Setenv(APIHostCert, value)
+	} else {
+		defer os.Unsetenv(APIHostCert)
+	}
+	os.Setenv(APIHostCert, "/does-not-exist/ca.pem")
+
+	// the system roots must not be trusted in place of the certificate
+	client := New(WithBaseURL(server.URL))
+	if _, err := client.ListTypes(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "/does-not-exist/ca.pem") {
+		t.Errorf("Expected the certificate error, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests to be sent, got %d", requests)
+	}
+}
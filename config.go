@@ -0,0 +1,215 @@
+package linodego
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// APIProfileVar environment var naming the profile used by NewClientFromConfig
+	APIProfileVar = "LINODE_PROFILE"
+	// DefaultConfigProfile is the profile used by NewClientFromConfig when none is named
+	DefaultConfigProfile = "default"
+)
+
+// Keys read from a profile of a config file by NewClientFromConfig
+const (
+	configToken      = "token"
+	configAPIHost    = "api_host"
+	configAPIVersion = "api_version"
+	configAPIScheme  = "api_scheme"
+	configCA         = "ca_file"
+	configUserAgent  = "user_agent"
+	configPollDelay  = "poll_delay"
+
+	// configDefaultUser is the linode-cli key of the [DEFAULT] section naming the default profile
+	configDefaultUser = "default-user"
+	// configDefaults is the section holding values shared by all profiles
+	configDefaults = "DEFAULT"
+)
+
+// DefaultConfigPath returns the path of the linode-cli config file,
+// $XDG_CONFIG_HOME/linode-cli or ~/.config/linode-cli
+func DefaultConfigPath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); len(dir) > 0 {
+		return filepath.Join(dir, "linode-cli"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "linode-cli"), nil
+}
+
+// NewClientFromConfig creates a Client configured by a profile of a linode-cli
+// style INI config file. Each section of the file is a profile, which may set:
+//
+//	token       the API token
+//	api_host    the API hostname, such as api.linode.com
+//	api_version the API version, such as v4
+//	api_scheme  http or https
+//	ca_file     the path of a PEM encoded CA certificate to trust
+//	user_agent  the User-Agent sent with requests
+//	poll_delay  the delay between WaitFor polls, such as 3s
+//
+// Values in the [DEFAULT] section apply to every profile.
+//
+// The path defaults to DefaultConfigPath(). The profile defaults to the
+// LINODE_PROFILE environment variable, then to the default-user of the
+// [DEFAULT] section, then to "default". A missing file is only an error when
+// the path or profile were given.
+//
+// Environment variables override the file: LINODE_TOKEN, LINODE_URL (which
+// replaces api_host, api_version and api_scheme), LINODE_API_VERSION and
+// LINODE_CA. Setters called on the returned Client override both.
+func NewClientFromConfig(path string, profile string) (*Client, error) {
+	if len(profile) == 0 {
+		profile = os.Getenv(APIProfileVar)
+	}
+
+	required := len(path) > 0 || len(profile) > 0
+	if len(path) == 0 {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil && required {
+			return nil, err
+		}
+	}
+
+	values, err := loadConfigProfile(path, profile)
+	if err != nil && (required || !os.IsNotExist(err)) {
+		return nil, err
+	}
+
+	client := New()
+	if err := client.applyConfig(values); err != nil {
+		return nil, fmt.Errorf("Error in config file %s: %s", path, err)
+	}
+	return client, nil
+}
+
+// applyConfig configures the Client with the profile's values, unless
+// overridden by the environment
+func (c *Client) applyConfig(values map[string]string) error {
+	if token := os.Getenv(APIEnvVar); len(token) > 0 {
+		c.SetToken(token)
+	} else if token := values[configToken]; len(token) > 0 {
+		c.SetToken(token)
+	}
+
+	if _, ok := os.LookupEnv(APIHostVar); !ok {
+		scheme, host, version := APIProto, APIHost, APIVersion
+		if v := values[configAPIScheme]; len(v) > 0 {
+			scheme = v
+		}
+		if v := values[configAPIHost]; len(v) > 0 {
+			host = strings.TrimSuffix(v, "/")
+		}
+		if v := values[configAPIVersion]; len(v) > 0 {
+			version = v
+		}
+		if v := os.Getenv(APIVersionVar); len(v) > 0 {
+			version = v
+		}
+		c.SetBaseURL(fmt.Sprintf("%s://%s/%s", scheme, host, version))
+	}
+
+	if _, ok := os.LookupEnv(APIHostCert); !ok {
+		if path := values[configCA]; len(path) > 0 {
+			if err := c.addRootCertificate(path); err != nil {
+				return fmt.Errorf("%s: %w", configCA, err)
+			}
+		}
+	}
+
+	if ua := values[configUserAgent]; len(ua) > 0 {
+		c.SetUserAgent(ua)
+	}
+
+	if delay := values[configPollDelay]; len(delay) > 0 {
+		d, err := time.ParseDuration(delay)
+		if err != nil {
+			return fmt.Errorf("%s: %s", configPollDelay, err)
+		}
+		c.SetPollDelay(d / time.Millisecond)
+	}
+
+	return nil
+}
+
+// loadConfigProfile returns the values of the profile in the config file at
+// path, merged over the values of its [DEFAULT] section
+func loadConfigProfile(path string, profile string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sections, err := parseINI(f)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing config file %s: %s", path, err)
+	}
+
+	defaults := sections[configDefaults]
+	if len(profile) == 0 {
+		profile = defaults[configDefaultUser]
+	}
+	if len(profile) == 0 {
+		profile = DefaultConfigProfile
+	}
+
+	section, ok := sections[profile]
+	if !ok {
+		return nil, fmt.Errorf("Could not find profile %q in config file %s", profile, path)
+	}
+
+	values := make(map[string]string, len(defaults)+len(section))
+	for k, v := range defaults {
+		values[k] = v
+	}
+	for k, v := range section {
+		values[k] = v
+	}
+	return values, nil
+}
+
+// parseINI parses the sections of an INI file. Keys are lowercased, and may be
+// separated from their values by "=" or ":". Lines starting with "#" or ";" are
+// comments.
+func parseINI(r io.Reader) (map[string]map[string]string, error) {
+	sections := make(map[string]map[string]string)
+	var section map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "["):
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header", n)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if section = sections[name]; section == nil {
+				section = make(map[string]string)
+				sections[name] = section
+			}
+		default:
+			i := strings.IndexAny(line, "=:")
+			if i < 0 {
+				return nil, fmt.Errorf("line %d: expected a key and value", n)
+			}
+			if section == nil {
+				return nil, fmt.Errorf("line %d: key outside of a section", n)
+			}
+			section[strings.ToLower(strings.TrimSpace(line[:i]))] = strings.TrimSpace(line[i+1:])
+		}
+	}
+	return sections, scanner.Err()
+}
@@ -0,0 +1,199 @@
+package linodego_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/linode/linodego"
+)
+
+// unsetEnv unsets the environment variables, returning a function restoring them
+func unsetEnv(keys ...string) (restore func()) {
+	values := map[string]string{}
+	for _, key := range keys {
+		if value, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			values[key] = value
+		}
+	}
+	return func() {
+		for key, value := range values {
+			os.Setenv(key, value)
+		}
+	}
+}
+
+// tempDir creates a temporary directory, which the test removes
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "linodego")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// writeConfig writes a config file for the server in dir, returning its path
+func writeConfig(t *testing.T, dir string, server *httptest.Server) string {
+	t.Helper()
+	host := strings.TrimPrefix(server.URL, "http://")
+	config := `
+[DEFAULT]
+default-user = work
+api_scheme = http
+api_host = ` + host + `
+
+; the main account
+[default]
+token = default-token
+
+[work]
+token: work-token
+api_version = v4beta
+user_agent = work-agent
+poll_delay = 250ms
+`
+	path := filepath.Join(dir, "linode-cli")
+	if err := ioutil.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// configHandler records the requests made to it, responding with a list of types
+func configHandler(requests *[]*http.Request) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		typesHandler().ServeHTTP(w, r)
+	})
+}
+
+func TestNewClientFromConfig_profiles(t *testing.T) {
+	defer unsetEnv(APIEnvVar, APIHostVar, APIHostCert, APIVersionVar, APIProfileVar)()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	var requests []*http.Request
+	server := httptest.NewServer(configHandler(&requests))
+	defer server.Close()
+	path := writeConfig(t, dir, server)
+
+	for _, test := range []struct {
+		profile, env, token, path, userAgent string
+	}{
+		{"", "", "Bearer work-token", "/v4beta/linode/types", "work-agent"},
+		{"default", "", "Bearer default-token", "/v4/linode/types", DefaultUserAgent},
+		{"", "default", "Bearer default-token", "/v4/linode/types", DefaultUserAgent},
+		{"work", "default", "Bearer work-token", "/v4beta/linode/types", "work-agent"},
+	} {
+		if len(test.env) > 0 {
+			os.Setenv(APIProfileVar, test.env)
+		} else {
+			os.Unsetenv(APIProfileVar)
+		}
+
+		requests = nil
+		client, err := NewClientFromConfig(path, test.profile)
+		if err != nil {
+			t.Fatalf("Error loading profile %q, got %v", test.profile, err)
+		}
+		if _, err := client.ListTypes(context.Background(), nil); err != nil {
+			t.Fatalf("Error listing types, got %v", err)
+		}
+
+		r := requests[0]
+		if r.Header.Get("Authorization") != test.token || r.URL.Path != test.path || r.UserAgent() != test.userAgent {
+			t.Errorf("Expected profile %q (env %q) to request %s with %q as %q, got %s with %q as %q", test.profile, test.env,
+				test.path, test.token, test.userAgent, r.URL.Path, r.Header.Get("Authorization"), r.UserAgent())
+		}
+	}
+	os.Unsetenv(APIProfileVar)
+}
+
+func TestNewClientFromConfig_precedence(t *testing.T) {
+	defer unsetEnv(APIEnvVar, APIHostVar, APIHostCert, APIVersionVar, APIProfileVar)()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	var requests []*http.Request
+	server := httptest.NewServer(configHandler(&requests))
+	defer server.Close()
+	path := writeConfig(t, dir, server)
+
+	os.Setenv(APIEnvVar, "env-token")
+	os.Setenv(APIVersionVar, "v4")
+	defer os.Unsetenv(APIEnvVar)
+	defer os.Unsetenv(APIVersionVar)
+
+	client, err := NewClientFromConfig(path, "work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing types, got %v", err)
+	}
+	if r := requests[0]; r.Header.Get("Authorization") != "Bearer env-token" || r.URL.Path != "/v4/linode/types" {
+		t.Errorf("Expected the environment to override the file, got %s with %q", r.URL.Path, r.Header.Get("Authorization"))
+	}
+
+	client.SetToken("explicit-token").SetUserAgent("explicit-agent")
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing types, got %v", err)
+	}
+	if r := requests[1]; r.Header.Get("Authorization") != "Bearer explicit-token" || r.UserAgent() != "explicit-agent" {
+		t.Errorf("Expected setters to override the file and environment, got %q as %q", r.Header.Get("Authorization"), r.UserAgent())
+	}
+}
+
+func TestNewClientFromConfig_errors(t *testing.T) {
+	defer unsetEnv(APIEnvVar, APIHostVar, APIHostCert, APIVersionVar, APIProfileVar)()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	server := httptest.NewServer(typesHandler())
+	defer server.Close()
+	path := writeConfig(t, dir, server)
+
+	if _, err := NewClientFromConfig(path, "missing"); err == nil || !strings.Contains(err.Error(), `"missing"`) {
+		t.Errorf("Expected an error for a missing profile, got %v", err)
+	}
+	if _, err := NewClientFromConfig(filepath.Join(dir, "missing"), ""); !os.IsNotExist(err) {
+		t.Errorf("Expected an error for a missing file, got %v", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid")
+	if err := ioutil.WriteFile(invalid, []byte("[default]\npoll_delay = soon\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewClientFromConfig(invalid, ""); err == nil || !strings.Contains(err.Error(), "poll_delay") {
+		t.Errorf("Expected an error for an invalid poll_delay, got %v", err)
+	}
+
+	notPEM := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(notPEM, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for name, ca := range map[string]string{"missing": filepath.Join(dir, "missing.pem"), "invalid": notPEM} {
+		config := filepath.Join(dir, "ca-"+name)
+		if err := ioutil.WriteFile(config, []byte("[default]\nca_file = "+ca+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewClientFromConfig(config, ""); err == nil || !strings.Contains(err.Error(), "ca_file") {
+			t.Errorf("Expected an error for a %s ca_file, got %v", name, err)
+		}
+	}
+
+	// without a path or profile, the environment alone may configure the client
+	defer unsetEnv("XDG_CONFIG_HOME")()
+	os.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+	if _, err := NewClientFromConfig("", ""); err != nil {
+		t.Errorf("Expected no error without a config file, got %v", err)
+	}
+}
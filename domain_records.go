@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -86,30 +85,28 @@ type DomainRecordsPagedResponse struct {
 	Data []DomainRecord `json:"data"`
 }
 
-// endpoint gets the endpoint URL for InstanceConfig
-func (DomainRecordsPagedResponse) endpointWithID(c *Client, id int) string {
-	endpoint, err := c.DomainRecords.endpointWithID(id)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends DomainRecords when processing paginated DomainRecord responses
-func (resp *DomainRecordsPagedResponse) appendData(r *DomainRecordsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *DomainRecordsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*DomainRecordsPagedResponse).Data...)
 }
 
 // ListDomainRecords lists DomainRecords
 func (c *Client) ListDomainRecords(ctx context.Context, domainID int, opts *ListOptions) ([]DomainRecord, error) {
 	response := DomainRecordsPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, domainID, opts)
+	err := c.listHelperWithID(ctx, "ListDomainRecords", &response, domainID, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListDomainRecordsFunc calls fn with each DomainRecord, fetching pages as they are needed
+func (c *Client) ListDomainRecordsFunc(ctx context.Context, domainID int, opts *ListOptions, fn func(DomainRecord) error) error {
+	return c.listFunc(ctx, "ListDomainRecordsFunc", &DomainRecordsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*DomainRecord))
+	}, domainID)
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (d *DomainRecord) fixDates() *DomainRecord {
 	return d
@@ -122,7 +119,7 @@ func (c *Client) GetDomainRecord(ctx context.Context, domainID int, id int) (*Do
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&DomainRecord{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetDomainRecord").SetResult(&DomainRecord{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -137,9 +134,9 @@ func (c *Client) CreateDomainRecord(ctx context.Context, domainID int, domainrec
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&DomainRecord{})
+	req := c.request(ctx, "CreateDomainRecord").SetResult(&DomainRecord{})
 
-	bodyData, err := json.Marshal(domainrecord)
+	bodyData, err := c.marshalBody(ctx, domainrecord)
 	if err != nil {
 		return nil, NewError(err)
 	}
@@ -164,9 +161,9 @@ func (c *Client) UpdateDomainRecord(ctx context.Context, domainID int, id int, d
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&DomainRecord{})
+	req := c.request(ctx, "UpdateDomainRecord").SetResult(&DomainRecord{})
 
-	if bodyData, err := json.Marshal(domainrecord); err == nil {
+	if bodyData, err := c.marshalBody(ctx, domainrecord); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -190,6 +187,6 @@ func (c *Client) DeleteDomainRecord(ctx context.Context, domainID int, id int) e
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteDomainRecord").Delete(e))
 	return err
 }
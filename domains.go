@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -186,30 +185,28 @@ type DomainsPagedResponse struct {
 	Data []Domain `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Domain
-func (DomainsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Domains.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Domains when processing paginated Domain responses
-func (resp *DomainsPagedResponse) appendData(r *DomainsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *DomainsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*DomainsPagedResponse).Data...)
 }
 
 // ListDomains lists Domains
 func (c *Client) ListDomains(ctx context.Context, opts *ListOptions) ([]Domain, error) {
 	response := DomainsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListDomains", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListDomainsFunc calls fn with each Domain, fetching pages as they are needed
+func (c *Client) ListDomainsFunc(ctx context.Context, opts *ListOptions, fn func(Domain) error) error {
+	return c.listFunc(ctx, "ListDomainsFunc", &DomainsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Domain))
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (d *Domain) fixDates() *Domain {
 	return d
@@ -222,7 +219,7 @@ func (c *Client) GetDomain(ctx context.Context, id int) (*Domain, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Domain{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetDomain").SetResult(&Domain{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -237,9 +234,9 @@ func (c *Client) CreateDomain(ctx context.Context, domain DomainCreateOptions) (
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Domain{})
+	req := c.request(ctx, "CreateDomain").SetResult(&Domain{})
 
-	bodyData, err := json.Marshal(domain)
+	bodyData, err := c.marshalBody(ctx, domain)
 	if err != nil {
 		return nil, NewError(err)
 	}
@@ -264,9 +261,9 @@ func (c *Client) UpdateDomain(ctx context.Context, id int, domain DomainUpdateOp
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&Domain{})
+	req := c.request(ctx, "UpdateDomain").SetResult(&Domain{})
 
-	if bodyData, err := json.Marshal(domain); err == nil {
+	if bodyData, err := c.marshalBody(ctx, domain); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -290,6 +287,6 @@ func (c *Client) DeleteDomain(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteDomain").Delete(e))
 	return err
 }
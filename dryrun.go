@@ -0,0 +1,141 @@
+package linodego
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrDryRun is matched by errors.Is for the errors returned by mutations made
+// in dry-run mode, when SetDryRunError is enabled
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// PlannedOperation is a request recorded instead of being sent in dry-run mode
+type PlannedOperation struct {
+	// Method is the HTTP method of the request
+	Method string `json:"method"`
+	// Endpoint is the requested path, relative to the API's base URL
+	Endpoint string `json:"endpoint"`
+	// Resource is the name of the requested Resource, such as "instances"
+	Resource string `json:"resource,omitempty"`
+	// Body is the JSON body of the request, if any, with secrets redacted
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// dryRun records the mutations planned by a Client in dry-run mode
+type dryRun struct {
+	mu   sync.Mutex
+	plan []PlannedOperation
+}
+
+// SetDryRun enables or disables dry-run mode. In dry-run mode, GET requests are
+// sent as usual, while mutations such as CreateInstance, DeleteVolume and
+// UpdateDomainRecord are recorded in the plan returned by DryRunPlan instead of
+// being sent. Mutations return a synthetic success, echoing the request body,
+// unless SetDryRunError is enabled. Disabling dry-run mode discards the plan.
+func (c *Client) SetDryRun(enabled bool) *Client {
+	c.transport.update(func(s *transportSettings) {
+		switch {
+		case !enabled:
+			s.dryRun = nil
+		case s.dryRun == nil:
+			s.dryRun = &dryRun{}
+		}
+	})
+	return c
+}
+
+// SetDryRunError sets whether mutations made in dry-run mode return an error
+// matching ErrDryRun, rather than a synthetic success. It may be called before
+// or after SetDryRun, and is kept when dry-run mode is disabled.
+func (c *Client) SetDryRunError(enabled bool) *Client {
+	c.transport.update(func(s *transportSettings) { s.dryRunError = enabled })
+	return c
+}
+
+// DryRunPlan returns the mutations recorded in dry-run mode, in the order they were made
+func (c *Client) DryRunPlan() []PlannedOperation {
+	d := c.transport.current().dryRun
+	if d == nil {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]PlannedOperation{}, d.plan...)
+}
+
+// DryRunPlanJSON returns the mutations recorded in dry-run mode as a JSON array
+func (c *Client) DryRunPlanJSON() ([]byte, error) {
+	plan := c.DryRunPlan()
+	if plan == nil {
+		plan = []PlannedOperation{}
+	}
+	return json.MarshalIndent(plan, "", "  ")
+}
+
+// ResetDryRunPlan discards the mutations recorded in dry-run mode
+func (c *Client) ResetDryRunPlan() *Client {
+	if d := c.transport.current().dryRun; d != nil {
+		d.mu.Lock()
+		d.plan = nil
+		d.mu.Unlock()
+	}
+	return c
+}
+
+// isMutation reports whether requests with the method change resources
+func isMutation(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+}
+
+// record records the request in the plan, returning the response or error it
+// receives, which is ErrDryRun when failing
+func (d *dryRun) record(req *http.Request, failing bool) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	op := PlannedOperation{Method: req.Method, Endpoint: strings.Trim(req.URL.Path, "/")}
+	if call := requestCallFromContext(req.Context()); call != nil {
+		op.Endpoint = call.info.Path
+		op.Resource = call.info.Resource
+	}
+	if json.Valid(body) {
+		op.Body = json.RawMessage(redact(string(body)))
+	}
+
+	d.mu.Lock()
+	d.plan = append(d.plan, op)
+	d.mu.Unlock()
+
+	if failing {
+		return nil, ErrDryRun
+	}
+
+	// The request body describes the resource as well as is known without the API
+	synthetic := []byte("{}")
+	if len(body) > 0 && body[0] == '{' && json.Valid(body) {
+		synthetic = body
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(synthetic)),
+		ContentLength: int64(len(synthetic)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package linodego_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	. "github.com/linode/linodego"
+)
+
+func TestSetDryRun(t *testing.T) {
+	var sent []string
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "existing", "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"}`))
+	}))
+	defer teardown()
+
+	client.SetDryRun(true)
+	ctx := context.Background()
+
+	volume, err := client.GetVolume(ctx, 123)
+	if err != nil || volume.Label != "existing" {
+		t.Fatalf("Expected GET requests to be sent, got %v and %v", volume, err)
+	}
+
+	instance, err := client.CreateInstance(ctx, InstanceCreateOptions{Region: "us-east", Label: "planned", RootPass: "secret-root-pass"})
+	if err != nil {
+		t.Fatalf("Expected a synthetic success, got %v", err)
+	}
+	if instance.Label != "planned" || instance.Region != "us-east" {
+		t.Errorf("Expected the synthetic instance to echo the request, got %+v", instance)
+	}
+	if err := client.DeleteVolume(ctx, 123); err != nil {
+		t.Errorf("Expected a synthetic success, got %v", err)
+	}
+	if _, err := client.UpdateDomainRecord(ctx, 12, 34, DomainRecordUpdateOptions{Target: "192.0.2.1"}); err != nil {
+		t.Errorf("Expected a synthetic success, got %v", err)
+	}
+
+	if !reflect.DeepEqual(sent, []string{"GET /volumes/123"}) {
+		t.Errorf("Expected only GET requests to be sent, got %v", sent)
+	}
+
+	plan := client.DryRunPlan()
+	if len(plan) != 3 {
+		t.Fatalf("Expected 3 planned operations, got %+v", plan)
+	}
+	expected := []PlannedOperation{
+		{Method: http.MethodPost, Endpoint: "linode/instances", Resource: "instances"},
+		{Method: http.MethodDelete, Endpoint: "volumes/123", Resource: "volumes"},
+		{Method: http.MethodPut, Endpoint: "domains/12/records/34", Resource: "records"},
+	}
+	for i, op := range plan {
+		if op.Method != expected[i].Method || op.Endpoint != expected[i].Endpoint || op.Resource != expected[i].Resource {
+			t.Errorf("Expected operation %d to be %+v, got %+v", i, expected[i], op)
+		}
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(plan[0].Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body["label"] != "planned" || body["root_pass"] != "[REDACTED]" {
+		t.Errorf("Expected the planned body with secrets redacted, got %s", plan[0].Body)
+	}
+
+	planJSON, err := client.DryRunPlanJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []PlannedOperation
+	if err := json.Unmarshal(planJSON, &decoded); err != nil || len(decoded) != 3 || decoded[2].Endpoint != "domains/12/records/34" {
+		t.Errorf("Expected the plan as JSON, got %s (%v)", planJSON, err)
+	}
+
+	client.ResetDryRunPlan()
+	if plan := client.DryRunPlan(); len(plan) != 0 {
+		t.Errorf("Expected the plan to be reset, got %+v", plan)
+	}
+}
+
+func TestSetDryRunError(t *testing.T) {
+	var requests int
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer teardown()
+
+	client.SetDryRun(true).SetDryRunError(true)
+
+	if err := client.DeleteVolume(context.Background(), 123); !errors.Is(err, ErrDryRun) {
+		t.Errorf("Expected ErrDryRun, got %v", err)
+	}
+	if requests != 0 || len(client.DryRunPlan()) != 1 {
+		t.Errorf("Expected the deletion to be planned and not sent, got %d requests", requests)
+	}
+
+	client.SetDryRun(false)
+	if err := client.DeleteVolume(context.Background(), 123); err != nil || requests != 1 {
+		t.Errorf("Expected the deletion to be sent once dry-run mode is disabled, got %v", err)
+	}
+
+	// the error setting is kept when dry-run mode is enabled again
+	client.SetDryRun(true)
+	if err := client.DeleteVolume(context.Background(), 123); !errors.Is(err, ErrDryRun) {
+		t.Errorf("Expected ErrDryRun after enabling dry-run mode again, got %v", err)
+	}
+}
+
+func TestSetDryRunError_beforeSetDryRun(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, typesHandler())
+	defer teardown()
+
+	client.SetDryRunError(true).SetDryRun(true)
+	if err := client.DeleteVolume(context.Background(), 123); !errors.Is(err, ErrDryRun) {
+		t.Errorf("Expected ErrDryRun when set before enabling dry-run mode, got %v", err)
+	}
+}
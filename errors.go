@@ -1,10 +1,11 @@
 package linodego
 
 import (
+	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
+	"unicode/utf8"
 
 	"gopkg.in/resty.v1"
 )
@@ -16,6 +17,22 @@ const (
 	ErrorFromError = 2
 	// ErrorFromStringer is the Code identifying Errors created by fmt.Stringer types
 	ErrorFromStringer = 3
+	// ErrorFromUnsupported is the Code identifying Errors created by unsupported types
+	ErrorFromUnsupported = 4
+)
+
+// errorBodySnippetLength is the most of an unexpected response body kept in an Error
+const errorBodySnippetLength = 256
+
+var (
+	// ErrNotFound is matched by errors.Is for API responses with a 404 status
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is matched by errors.Is for API responses with a 409 status
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited is matched by errors.Is for API responses with a 429 status
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServerError is matched by errors.Is for API responses with a 5xx status
+	ErrServerError = errors.New("server error")
 )
 
 // Error wraps the LinodeGo error with the relevant http.Response
@@ -23,6 +40,12 @@ type Error struct {
 	Response *http.Response
 	Code     int
 	Message  string
+
+	// Reasons are the individual errors returned by the API, if any
+	Reasons []APIErrorReason
+
+	// err is the error this Error was created from, if any
+	err error
 }
 
 // APIErrorReason is an individual invalid request message returned by the Linode API
@@ -44,6 +67,11 @@ type APIError struct {
 }
 
 func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
+	// Error responses which could not be decoded are still described by their status
+	if r != nil && r.RawResponse != nil && r.IsError() {
+		return nil, NewError(r)
+	}
+
 	if err != nil {
 		return nil, NewError(err)
 	}
@@ -71,10 +99,70 @@ func (g Error) Error() string {
 	return fmt.Sprintf("[%03d] %s", g.Code, g.Message)
 }
 
+// Unwrap returns the error this Error was created from, if any
+func (g Error) Unwrap() error {
+	return g.err
+}
+
+// Is reports whether the Error matches the target, allowing errors.Is to
+// compare an Error against ErrNotFound, ErrConflict, ErrRateLimited and ErrServerError
+func (g Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return g.Code == http.StatusNotFound
+	case ErrConflict:
+		return g.Code == http.StatusConflict
+	case ErrRateLimited:
+		return g.Code == http.StatusTooManyRequests
+	case ErrServerError:
+		return g.Code >= 500 && g.Code < 600
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an API error with a 404 status
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether err is an API error with a 409 status
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsRateLimited reports whether err is an API error with a 429 status
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsServerError reports whether err is an API error with a 5xx status
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServerError)
+}
+
+// FieldErrors returns the reasons given by the API for each invalid field
+// of a request. Reasons that do not apply to a specific field are not included.
+func FieldErrors(err error) map[string][]string {
+	fields := map[string][]string{}
+
+	var e *Error
+	if !errors.As(err, &e) {
+		return fields
+	}
+
+	for _, reason := range e.Reasons {
+		if len(reason.Field) > 0 {
+			fields[reason.Field] = append(fields[reason.Field], reason.Reason)
+		}
+	}
+	return fields
+}
+
 // NewError creates a linodego.Error with a Code identifying the source err type,
 // - ErrorFromString   (1) from a string
 // - ErrorFromError    (2) for an error
 // - ErrorFromStringer (3) for a Stringer
+// - ErrorFromUnsupported (4) for any other type
 // - HTTP Status Codes (100-600) for a resty.Response object
 func NewError(err interface{}) *Error {
 	if err == nil {
@@ -85,25 +173,58 @@ func NewError(err interface{}) *Error {
 	case *Error:
 		return e
 	case *resty.Response:
+		if e.RawResponse == nil {
+			return &Error{Code: ErrorFromString, Message: "No response received"}
+		}
+
 		apiError, ok := e.Error().(*APIError)
 
-		if !ok {
-			log.Fatalln("Unexpected Resty Error Response")
+		if !ok || len(apiError.Errors) == 0 {
+			// The response was not an API error, such as an HTML page from a proxy
+			return &Error{
+				Code:     e.RawResponse.StatusCode,
+				Message:  unexpectedResponseMessage(e.RawResponse, e.Body()),
+				Response: e.RawResponse,
+			}
 		}
 
 		return &Error{
 			Code:     e.RawResponse.StatusCode,
 			Message:  apiError.Error(),
 			Response: e.RawResponse,
+			Reasons:  apiError.Errors,
 		}
 	case error:
-		return &Error{Code: ErrorFromError, Message: e.Error()}
+		return &Error{Code: ErrorFromError, Message: e.Error(), err: e}
 	case string:
 		return &Error{Code: ErrorFromString, Message: e}
 	case fmt.Stringer:
 		return &Error{Code: ErrorFromStringer, Message: e.String()}
 	default:
-		log.Fatalln("Unsupported type to linodego.NewError")
-		panic(err)
+		return &Error{Code: ErrorFromUnsupported, Message: fmt.Sprintf("Unsupported type %T to linodego.NewError: %v", err, err)}
+	}
+}
+
+// unexpectedResponseMessage describes a response that did not contain an API error
+func unexpectedResponseMessage(resp *http.Response, body []byte) string {
+	message := http.StatusText(resp.StatusCode)
+	if len(message) == 0 {
+		message = resp.Status
+	}
+
+	// Secrets are redacted in case the body echoes the request
+	snippet := strings.Join(strings.Fields(redact(string(body))), " ")
+	if len(snippet) > errorBodySnippetLength {
+		// Cut at the start of a rune, so that none is split
+		end := errorBodySnippetLength
+		for end > 0 && !utf8.RuneStart(snippet[end]) {
+			end--
+		}
+		snippet = snippet[:end] + "..."
+	}
+
+	if len(snippet) == 0 {
+		return message
 	}
+	return fmt.Sprintf("%s: %s", message, snippet)
 }
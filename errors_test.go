@@ -0,0 +1,154 @@
+package linodego_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/linode/linodego"
+)
+
+func TestError_statusHelpers(t *testing.T) {
+	status := http.StatusNotFound
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Something went wrong"}]}`))
+	}))
+	defer teardown()
+
+	for _, tc := range []struct {
+		status  int
+		target  error
+		matches func(error) bool
+	}{
+		{http.StatusNotFound, ErrNotFound, IsNotFound},
+		{http.StatusConflict, ErrConflict, IsConflict},
+		{http.StatusTooManyRequests, ErrRateLimited, IsRateLimited},
+		{http.StatusBadGateway, ErrServerError, IsServerError},
+	} {
+		status = tc.status
+
+		_, err := client.GetInstance(context.Background(), 123)
+		if !errors.Is(err, tc.target) {
+			t.Errorf("Expected a %d response to match %v, got %v", tc.status, tc.target, err)
+		}
+		if !tc.matches(err) {
+			t.Errorf("Expected a %d response to match its helper, got %v", tc.status, err)
+		}
+		if errors.Is(err, ErrConflict) && tc.target != ErrConflict {
+			t.Errorf("Expected a %d response not to match ErrConflict", tc.status)
+		}
+	}
+}
+
+func TestError_fieldErrors(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors": [
+			{"reason": "Label must be unique", "field": "label"},
+			{"reason": "Label too long", "field": "label"},
+			{"reason": "Region is not valid", "field": "region"},
+			{"reason": "Request failed"}
+		]}`))
+	}))
+	defer teardown()
+
+	_, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Label: "duplicate"})
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *Error, got %v", err)
+	}
+	if len(apiErr.Reasons) != 4 {
+		t.Errorf("Expected the API error reasons to be kept, got %v", apiErr.Reasons)
+	}
+
+	expected := map[string][]string{
+		"label":  {"Label must be unique", "Label too long"},
+		"region": {"Region is not valid"},
+	}
+	if fields := FieldErrors(err); !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, fields)
+	}
+}
+
+func TestError_unwrap(t *testing.T) {
+	err := NewError(io.ErrUnexpectedEOF)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected Error to wrap the error it was created from, got %v", err)
+	}
+	if IsNotFound(err) || IsServerError(err) {
+		t.Errorf("Expected an Error without an HTTP status not to match status helpers")
+	}
+	if fields := FieldErrors(errors.New("plain")); len(fields) != 0 {
+		t.Errorf("Expected no field errors from a plain error, got %v", fields)
+	}
+}
+
+func TestError_nonJSONResponse(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<html>\n<head><title>502 Bad Gateway</title></head>\n" + strings.Repeat("<br/>", 100) + "</html>"))
+	}))
+	defer teardown()
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	if err == nil {
+		t.Fatalf("Expected an error from an HTML 502 response, got %v", instance)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *Error, got %v", err)
+	}
+	if apiErr.Code != http.StatusBadGateway || apiErr.Response == nil {
+		t.Errorf("Expected the Error to keep the 502 response, got %v", apiErr)
+	}
+	if !strings.Contains(apiErr.Message, "<title>502 Bad Gateway</title>") {
+		t.Errorf("Expected the Error to include the body, got %q", apiErr.Message)
+	}
+	if len(apiErr.Message) > 300 {
+		t.Errorf("Expected the Error to include only a snippet of the body, got %d characters", len(apiErr.Message))
+	}
+	if !IsServerError(err) {
+		t.Errorf("Expected a 502 response to be a server error")
+	}
+}
+
+func TestError_snippetRunes(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("x" + strings.Repeat("é", 200)))
+	}))
+	defer teardown()
+
+	_, err := client.GetInstance(context.Background(), 123)
+	if err == nil || !utf8.ValidString(err.Error()) || !strings.HasSuffix(err.Error(), "é...") {
+		t.Errorf("Expected the snippet to be cut between runes, got %q", err)
+	}
+}
+
+func TestError_emptyResponse(t *testing.T) {
+	client, teardown := createTestClient(t, "fixtures/TestListTypes_429")
+	defer teardown()
+
+	_, err := client.ListTypes(context.Background(), nil)
+	if !IsRateLimited(err) {
+		t.Errorf("Expected an empty 429 response to be rate limited, got %v", err)
+	}
+}
+
+func TestNewError_unsupported(t *testing.T) {
+	if err := NewError(42); err.Code != ErrorFromUnsupported {
+		t.Errorf("Expected an unsupported type to create an Error, got %v", err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/linode/linodego"
 )
@@ -92,7 +93,7 @@ func Example() {
 			log.Fatalln("* While renaming instance: ", err)
 		}
 		fmt.Println("## Created Instance")
-		event, errEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionLinodeCreate, *linode.Created, 240)
+		event, errEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionLinodeCreate, *linode.Created, 240*time.Second)
 		if errEvent != nil {
 			log.Fatalf("* Failed to wait for Linode %d to finish creation: %s", linode.ID, errEvent)
 		}
@@ -104,7 +105,7 @@ func Example() {
 		if errSwap != nil {
 			log.Fatalln("* While creating swap disk:", errSwap)
 		}
-		eventSwap, errSwapEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionDiskCreate, diskSwap.Created, 240)
+		eventSwap, errSwapEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionDiskCreate, diskSwap.Created, 240*time.Second)
 		// @TODO it is not sufficient that a disk was created. Which disk was it?
 		// Sounds like we'll need a WaitForEntityStatus function.
 		if errSwapEvent != nil {
@@ -118,7 +119,7 @@ func Example() {
 		if errRaw != nil {
 			log.Fatalln("* While creating raw disk:", errRaw)
 		}
-		eventRaw, errRawEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionDiskCreate, diskRaw.Created, 240)
+		eventRaw, errRawEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionDiskCreate, diskRaw.Created, 240*time.Second)
 		// @TODO it is not sufficient that a disk was created. Which disk was it?
 		// Sounds like we'll need a WaitForEntityStatus function.
 		if errRawEvent != nil {
@@ -142,7 +143,7 @@ func Example() {
 		if errDebian != nil {
 			log.Fatalln("* While creating Debian disk:", errDebian)
 		}
-		eventDebian, errDebianEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionDiskCreate, diskDebian.Created, 240)
+		eventDebian, errDebianEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionDiskCreate, diskDebian.Created, 240*time.Second)
 		// @TODO it is not sufficient that a disk was created. Which disk was it?
 		// Sounds like we'll need a WaitForEntityStatus function.
 		if errDebianEvent != nil {
@@ -190,7 +191,7 @@ func Example() {
 		}
 		fmt.Println("### Booted Instance")
 
-		eventBooted, errBootEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionLinodeBoot, *config.Updated, 240)
+		eventBooted, errBootEvent := linodeClient.WaitForEventFinished(context.Background(), linode.ID, linodego.EntityLinode, linodego.ActionLinodeBoot, *config.Updated, 240*time.Second)
 		if errBootEvent != nil {
 			fmt.Println("### Boot Instance failed as expected:", errBootEvent)
 		} else {
@@ -0,0 +1,288 @@
+// Package filter builds the X-Filter expressions accepted by the Linode API
+// List endpoints.
+//
+//	f := filter.And(filter.Eq("region", "us-east"), filter.Gte("id", 100)).OrderBy("created", filter.Desc)
+//	xFilter, err := f.Build()
+//	if err != nil {
+//		return err
+//	}
+//	instances, err := client.ListInstances(ctx, linodego.NewListOptions(0, xFilter))
+//
+// Filters are validated as they are serialized, so that malformed
+// expressions are reported before a request is sent.
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Operator is an X-Filter operator
+type Operator string
+
+// Operator constants are the operators supported by the Linode API
+const (
+	OpAnd      Operator = "+and"
+	OpOr       Operator = "+or"
+	OpGt       Operator = "+gt"
+	OpGte      Operator = "+gte"
+	OpLt       Operator = "+lt"
+	OpLte      Operator = "+lte"
+	OpContains Operator = "+contains"
+	OpNeq      Operator = "+neq"
+)
+
+// Order is the direction results are sorted by OrderBy
+type Order string
+
+// Order constants are the sort directions supported by the Linode API
+const (
+	Asc  Order = "asc"
+	Desc Order = "desc"
+)
+
+// Filter is a condition, or a combination of conditions, on the fields of the
+// results returned by a List endpoint
+type Filter struct {
+	op       Operator
+	field    string
+	value    interface{}
+	children []*Filter
+	orderBy  string
+	order    Order
+}
+
+// Eq matches results where field is equal to value
+func Eq(field string, value interface{}) *Filter {
+	return &Filter{field: field, value: value}
+}
+
+// Neq matches results where field is not equal to value
+func Neq(field string, value interface{}) *Filter {
+	return &Filter{op: OpNeq, field: field, value: value}
+}
+
+// Gt matches results where field is greater than value
+func Gt(field string, value interface{}) *Filter {
+	return &Filter{op: OpGt, field: field, value: value}
+}
+
+// Gte matches results where field is greater than or equal to value
+func Gte(field string, value interface{}) *Filter {
+	return &Filter{op: OpGte, field: field, value: value}
+}
+
+// Lt matches results where field is less than value
+func Lt(field string, value interface{}) *Filter {
+	return &Filter{op: OpLt, field: field, value: value}
+}
+
+// Lte matches results where field is less than or equal to value
+func Lte(field string, value interface{}) *Filter {
+	return &Filter{op: OpLte, field: field, value: value}
+}
+
+// Contains matches results where field contains the substring value
+func Contains(field string, value string) *Filter {
+	return &Filter{op: OpContains, field: field, value: value}
+}
+
+// And matches results that match all of the filters
+func And(filters ...*Filter) *Filter {
+	return &Filter{op: OpAnd, children: filters}
+}
+
+// Or matches results that match any of the filters
+func Or(filters ...*Filter) *Filter {
+	return &Filter{op: OpOr, children: filters}
+}
+
+// OrderBy sorts the results by field. It may only be used on the outermost Filter.
+func (f *Filter) OrderBy(field string, order Order) *Filter {
+	f.orderBy = field
+	f.order = order
+	return f
+}
+
+// Validate reports whether the Filter can be serialized into an X-Filter
+// expression that the Linode API will accept
+func (f *Filter) Validate() error {
+	return f.validate(true)
+}
+
+func (f *Filter) validate(root bool) error {
+	if f == nil {
+		return fmt.Errorf("filter: nil filter")
+	}
+
+	if len(f.orderBy) > 0 || len(f.order) > 0 {
+		if !root {
+			return fmt.Errorf("filter: +order_by may only be used on the outermost filter")
+		}
+		if err := validateField(f.orderBy); err != nil {
+			return err
+		}
+		if f.order != Asc && f.order != Desc {
+			return fmt.Errorf("filter: +order must be %q or %q, got %q", Asc, Desc, f.order)
+		}
+	}
+
+	switch f.op {
+	case OpAnd, OpOr:
+		if len(f.children) == 0 {
+			return fmt.Errorf("filter: %s requires at least one filter", f.op)
+		}
+		for _, child := range f.children {
+			if err := child.validate(false); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "", OpNeq:
+		if err := validateField(f.field); err != nil {
+			return err
+		}
+		return validateValue(f.op, f.value, true)
+	case OpGt, OpGte, OpLt, OpLte:
+		if err := validateField(f.field); err != nil {
+			return err
+		}
+		return validateValue(f.op, f.value, false)
+	case OpContains:
+		if err := validateField(f.field); err != nil {
+			return err
+		}
+		if s, ok := f.value.(string); !ok || len(s) == 0 {
+			return fmt.Errorf("filter: %s on %q requires a non-empty string", f.op, f.field)
+		}
+		return nil
+	default:
+		return fmt.Errorf("filter: unknown operator %q", f.op)
+	}
+}
+
+func validateField(field string) error {
+	if len(field) == 0 {
+		return fmt.Errorf("filter: field name is required")
+	}
+	if strings.HasPrefix(field, "+") {
+		return fmt.Errorf("filter: field name %q may not start with '+'", field)
+	}
+	return nil
+}
+
+// validateValue ensures the value compared to a field is a scalar. Equality
+// comparisons may also compare against null.
+func validateValue(op Operator, value interface{}, nullable bool) error {
+	if value == nil {
+		if nullable {
+			return nil
+		}
+		return fmt.Errorf("filter: %s requires a value", op)
+	}
+
+	if _, ok := value.(time.Time); ok {
+		return nil
+	}
+
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Bool:
+		if !nullable {
+			return fmt.Errorf("filter: %s can not compare boolean values", op)
+		}
+		return nil
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return nil
+	default:
+		return fmt.Errorf("filter: unsupported value %v of type %T", value, value)
+	}
+}
+
+// MarshalJSON serializes the Filter into an X-Filter expression
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	expr := f.expression()
+	if len(f.orderBy) > 0 {
+		expr["+order_by"] = f.orderBy
+		expr["+order"] = f.order
+	}
+	return json.Marshal(expr)
+}
+
+// expression returns the JSON object representing a validated Filter
+func (f *Filter) expression() map[string]interface{} {
+	switch f.op {
+	case OpAnd, OpOr:
+		if f.op == OpAnd {
+			if merged, ok := f.mergedExpression(); ok {
+				return merged
+			}
+		}
+
+		children := make([]map[string]interface{}, len(f.children))
+		for i, child := range f.children {
+			children[i] = child.expression()
+		}
+		return map[string]interface{}{string(f.op): children}
+	case "":
+		return map[string]interface{}{f.field: formatValue(f.value)}
+	default:
+		return map[string]interface{}{
+			f.field: map[string]interface{}{string(f.op): formatValue(f.value)},
+		}
+	}
+}
+
+// mergedExpression combines conditions on distinct fields into a single
+// object, which the API treats as a conjunction, when possible
+func (f *Filter) mergedExpression() (map[string]interface{}, bool) {
+	merged := map[string]interface{}{}
+	for _, child := range f.children {
+		if child.op == OpAnd || child.op == OpOr {
+			return nil, false
+		}
+		if _, exists := merged[child.field]; exists {
+			return nil, false
+		}
+		for k, v := range child.expression() {
+			merged[k] = v
+		}
+	}
+	return merged, true
+}
+
+// formatValue converts times into the format used by the API
+func formatValue(value interface{}) interface{} {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format("2006-01-02T15:04:05")
+	}
+	return value
+}
+
+// Build validates the Filter and returns the X-Filter expression, suitable
+// for use as linodego.ListOptions.Filter
+func (f *Filter) Build() (string, error) {
+	b, err := f.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// String returns the X-Filter expression, or an empty string if the Filter is not valid
+func (f *Filter) String() string {
+	s, err := f.Build()
+	if err != nil {
+		return ""
+	}
+	return s
+}
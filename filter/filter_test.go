@@ -0,0 +1,113 @@
+package filter_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego/filter"
+)
+
+func assertFilterJSON(t *testing.T, f *filter.Filter, expected string) {
+	t.Helper()
+
+	got, err := f.Build()
+	if err != nil {
+		t.Fatalf("Error building filter: %s", err)
+	}
+
+	var gotValue, expectedValue interface{}
+	if err := json.Unmarshal([]byte(got), &gotValue); err != nil {
+		t.Fatalf("Filter %s is not valid JSON: %s", got, err)
+	}
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		t.Fatalf("Expected filter %s is not valid JSON: %s", expected, err)
+	}
+	if !reflect.DeepEqual(gotValue, expectedValue) {
+		t.Errorf("Expected filter %s, got %s", expected, got)
+	}
+}
+
+func TestFilter_comparisons(t *testing.T) {
+	assertFilterJSON(t, filter.Eq("label", "web"), `{"label":"web"}`)
+	assertFilterJSON(t, filter.Eq("mine", true), `{"mine":true}`)
+	assertFilterJSON(t, filter.Neq("status", "offline"), `{"status":{"+neq":"offline"}}`)
+	assertFilterJSON(t, filter.Gt("id", 10), `{"id":{"+gt":10}}`)
+	assertFilterJSON(t, filter.Gte("vcpus", 2), `{"vcpus":{"+gte":2}}`)
+	assertFilterJSON(t, filter.Lt("memory", 4096), `{"memory":{"+lt":4096}}`)
+	assertFilterJSON(t, filter.Lte("disk", 81920), `{"disk":{"+lte":81920}}`)
+	assertFilterJSON(t, filter.Contains("label", "prod"), `{"label":{"+contains":"prod"}}`)
+
+	created := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	assertFilterJSON(t, filter.Gte("created", created), `{"created":{"+gte":"2018-01-02T03:04:05"}}`)
+}
+
+func TestFilter_logical(t *testing.T) {
+	assertFilterJSON(t,
+		filter.And(filter.Eq("region", "us-east"), filter.Gte("vcpus", 2)),
+		`{"region":"us-east","vcpus":{"+gte":2}}`)
+
+	assertFilterJSON(t,
+		filter.And(filter.Gte("id", 10), filter.Lt("id", 20)),
+		`{"+and":[{"id":{"+gte":10}},{"id":{"+lt":20}}]}`)
+
+	assertFilterJSON(t,
+		filter.Or(filter.Eq("region", "us-east"), filter.Eq("region", "us-west")),
+		`{"+or":[{"region":"us-east"},{"region":"us-west"}]}`)
+
+	assertFilterJSON(t,
+		filter.And(filter.Eq("type", "g6-standard-2"), filter.Or(filter.Eq("region", "us-east"), filter.Contains("label", "web"))),
+		`{"+and":[{"type":"g6-standard-2"},{"+or":[{"region":"us-east"},{"label":{"+contains":"web"}}]}]}`)
+}
+
+func TestFilter_orderBy(t *testing.T) {
+	assertFilterJSON(t,
+		filter.Eq("seen", false).OrderBy("created", filter.Desc),
+		`{"seen":false,"+order_by":"created","+order":"desc"}`)
+
+	assertFilterJSON(t,
+		filter.Or(filter.Eq("region", "us-east"), filter.Eq("region", "us-west")).OrderBy("label", filter.Asc),
+		`{"+or":[{"region":"us-east"},{"region":"us-west"}],"+order_by":"label","+order":"asc"}`)
+}
+
+func TestFilter_invalid(t *testing.T) {
+	invalid := map[string]*filter.Filter{
+		"empty field":          filter.Eq("", "web"),
+		"operator as field":    filter.Eq("+and", "web"),
+		"empty and":            filter.And(),
+		"empty or":             filter.Or(),
+		"nil child":            filter.And(filter.Eq("label", "web"), nil),
+		"nested order_by":      filter.And(filter.Eq("label", "web").OrderBy("created", filter.Desc)),
+		"unknown order":        filter.Eq("label", "web").OrderBy("created", "sideways"),
+		"order without field":  filter.Eq("label", "web").OrderBy("", filter.Asc),
+		"range on bool":        filter.Gt("mine", true),
+		"range on nil":         filter.Lt("id", nil),
+		"empty contains":       filter.Contains("label", ""),
+		"non-scalar value":     filter.Eq("tags", []string{"web"}),
+		"deeply nested errors": filter.Or(filter.And(filter.Eq("label", "web"), filter.Gte("", 1))),
+	}
+
+	for name, f := range invalid {
+		if err := f.Validate(); err == nil {
+			t.Errorf("Expected %s filter to be invalid", name)
+		}
+		if _, err := f.Build(); err == nil {
+			t.Errorf("Expected %s filter to fail to build", name)
+		}
+		if s := f.String(); s != "" {
+			t.Errorf("Expected %s filter to have no string value, got %s", name, s)
+		}
+	}
+}
+
+func TestFilter_marshalNested(t *testing.T) {
+	f := filter.Eq("label", "web")
+	b, err := json.Marshal(map[string]interface{}{"filter": f})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"filter":{"label":"web"}}` {
+		t.Errorf("Unexpected JSON %s", b)
+	}
+}
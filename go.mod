@@ -1,13 +1,11 @@
 module github.com/linode/linodego
 
+go 1.13
+
 require (
 	github.com/dnaeon/go-vcr v0.0.0-20180814043457-aafff18a5cc2
-	github.com/golang/protobuf v1.2.0 // indirect
 	github.com/kr/pretty v0.1.0 // indirect
-	golang.org/x/net v0.0.0-20180826012351-8a410e7b638d // indirect
-	golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be
-	golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f // indirect
-	google.golang.org/appengine v1.1.0 // indirect
+	golang.org/x/oauth2 v0.0.0-20190220154721-9b3c75971fc9
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 	gopkg.in/resty.v1 v1.9.1
 	gopkg.in/yaml.v2 v2.2.1 // indirect
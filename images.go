@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -62,22 +61,14 @@ type ImagesPagedResponse struct {
 	Data []Image `json:"data"`
 }
 
-func (ImagesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Images.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
-func (resp *ImagesPagedResponse) appendData(r *ImagesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *ImagesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*ImagesPagedResponse).Data...)
 }
 
 // ListImages lists Images
 func (c *Client) ListImages(ctx context.Context, opts *ListOptions) ([]Image, error) {
 	response := ImagesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListImages", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -88,6 +79,13 @@ func (c *Client) ListImages(ctx context.Context, opts *ListOptions) ([]Image, er
 
 }
 
+// ListImagesFunc calls fn with each Image, fetching pages as they are needed
+func (c *Client) ListImagesFunc(ctx context.Context, opts *ListOptions, fn func(Image) error) error {
+	return c.listFunc(ctx, "ListImagesFunc", &ImagesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Image).fixDates())
+	})
+}
+
 // GetImage gets the Image with the provided ID
 func (c *Client) GetImage(ctx context.Context, id string) (*Image, error) {
 	e, err := c.Images.Endpoint()
@@ -95,7 +93,7 @@ func (c *Client) GetImage(ctx context.Context, id string) (*Image, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.Images.R(ctx).Get(e))
+	r, err := coupleAPIErrors(c.Images.R(withOperation(ctx, "GetImage")).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -110,9 +108,9 @@ func (c *Client) CreateImage(ctx context.Context, createOpts ImageCreateOptions)
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Image{})
+	req := c.request(ctx, "CreateImage").SetResult(&Image{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -137,9 +135,9 @@ func (c *Client) UpdateImage(ctx context.Context, id string, updateOpts ImageUpd
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	req := c.R(ctx).SetResult(&Image{})
+	req := c.request(ctx, "UpdateImage").SetResult(&Image{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -163,6 +161,6 @@ func (c *Client) DeleteImage(ctx context.Context, id string) error {
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteImage").Delete(e))
 	return err
 }
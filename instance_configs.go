@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -126,24 +125,15 @@ func (i InstanceConfig) GetUpdateOptions() InstanceConfigUpdateOptions {
 	}
 }
 
-// endpointWithID gets the endpoint URL for InstanceConfigs of a given Instance
-func (InstanceConfigsPagedResponse) endpointWithID(c *Client, id int) string {
-	endpoint, err := c.InstanceConfigs.endpointWithID(id)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends InstanceConfigs when processing paginated InstanceConfig responses
-func (resp *InstanceConfigsPagedResponse) appendData(r *InstanceConfigsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *InstanceConfigsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*InstanceConfigsPagedResponse).Data...)
 }
 
 // ListInstanceConfigs lists InstanceConfigs
 func (c *Client) ListInstanceConfigs(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceConfig, error) {
 	response := InstanceConfigsPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, linodeID, opts)
+	err := c.listHelperWithID(ctx, "ListInstanceConfigs", &response, linodeID, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -153,6 +143,13 @@ func (c *Client) ListInstanceConfigs(ctx context.Context, linodeID int, opts *Li
 	return response.Data, nil
 }
 
+// ListInstanceConfigsFunc calls fn with each InstanceConfig, fetching pages as they are needed
+func (c *Client) ListInstanceConfigsFunc(ctx context.Context, linodeID int, opts *ListOptions, fn func(InstanceConfig) error) error {
+	return c.listFunc(ctx, "ListInstanceConfigsFunc", &InstanceConfigsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*InstanceConfig).fixDates())
+	}, linodeID)
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *InstanceConfig) fixDates() *InstanceConfig {
 	i.Created, _ = parseDates(i.CreatedStr)
@@ -167,7 +164,7 @@ func (c *Client) GetInstanceConfig(ctx context.Context, linodeID int, configID i
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceConfig{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceConfig").SetResult(&InstanceConfig{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -182,9 +179,9 @@ func (c *Client) CreateInstanceConfig(ctx context.Context, linodeID int, createO
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&InstanceConfig{})
+	req := c.request(ctx, "CreateInstanceConfig").SetResult(&InstanceConfig{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, err
@@ -209,9 +206,9 @@ func (c *Client) UpdateInstanceConfig(ctx context.Context, linodeID int, configI
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
-	req := c.R(ctx).SetResult(&InstanceConfig{})
+	req := c.request(ctx, "UpdateInstanceConfig").SetResult(&InstanceConfig{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, err
@@ -241,6 +238,6 @@ func (c *Client) DeleteInstanceConfig(ctx context.Context, linodeID int, configI
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteInstanceConfig").Delete(e))
 	return err
 }
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -56,7 +55,7 @@ type InstanceDiskCreateOptions struct {
 
 	// Image is optional, but requires RootPass if provided
 	Image    string `json:"image,omitempty"`
-	RootPass string `json:"root_pass,omitempty"`
+	RootPass string `json:"root_pass,omitempty" redact:"true"`
 
 	Filesystem      string            `json:"filesystem,omitempty"`
 	AuthorizedKeys  []string          `json:"authorized_keys,omitempty"`
@@ -72,24 +71,20 @@ type InstanceDiskUpdateOptions struct {
 	ReadOnly bool   `json:"read_only"`
 }
 
-// endpointWithID gets the endpoint URL for InstanceDisks of a given Instance
-func (InstanceDisksPagedResponse) endpointWithID(c *Client, id int) string {
-	endpoint, err := c.InstanceDisks.endpointWithID(id)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
+// instanceDiskPasswordResetOptions is the request body of PasswordResetInstanceDisk
+type instanceDiskPasswordResetOptions struct {
+	Password string `json:"password" redact:"true"`
 }
 
 // appendData appends InstanceDisks when processing paginated InstanceDisk responses
-func (resp *InstanceDisksPagedResponse) appendData(r *InstanceDisksPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *InstanceDisksPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*InstanceDisksPagedResponse).Data...)
 }
 
 // ListInstanceDisks lists InstanceDisks
 func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceDisk, error) {
 	response := InstanceDisksPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, linodeID, opts)
+	err := c.listHelperWithID(ctx, "ListInstanceDisks", &response, linodeID, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -99,6 +94,13 @@ func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *List
 	return response.Data, nil
 }
 
+// ListInstanceDisksFunc calls fn with each InstanceDisk, fetching pages as they are needed
+func (c *Client) ListInstanceDisksFunc(ctx context.Context, linodeID int, opts *ListOptions, fn func(InstanceDisk) error) error {
+	return c.listFunc(ctx, "ListInstanceDisksFunc", &InstanceDisksPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*InstanceDisk).fixDates())
+	}, linodeID)
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *InstanceDisk) fixDates() *InstanceDisk {
 	if created, err := parseDates(v.CreatedStr); err == nil {
@@ -117,7 +119,7 @@ func (c *Client) GetInstanceDisk(ctx context.Context, linodeID int, configID int
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceDisk{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceDisk").SetResult(&InstanceDisk{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -132,9 +134,9 @@ func (c *Client) CreateInstanceDisk(ctx context.Context, linodeID int, createOpt
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&InstanceDisk{})
+	req := c.request(ctx, "CreateInstanceDisk").SetResult(&InstanceDisk{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -160,9 +162,9 @@ func (c *Client) UpdateInstanceDisk(ctx context.Context, linodeID int, diskID in
 	}
 	e = fmt.Sprintf("%s/%d", e, diskID)
 
-	req := c.R(ctx).SetResult(&InstanceDisk{})
+	req := c.request(ctx, "UpdateInstanceDisk").SetResult(&InstanceDisk{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -193,12 +195,12 @@ func (c *Client) ResizeInstanceDisk(ctx context.Context, linodeID int, diskID in
 	}
 	e = fmt.Sprintf("%s/%d/resize", e, diskID)
 
-	req := c.R(ctx).SetResult(&InstanceDisk{})
+	req := c.request(ctx, "ResizeInstanceDisk").SetResult(&InstanceDisk{})
 	updateOpts := map[string]interface{}{
 		"size": size,
 	}
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return NewError(err)
@@ -220,12 +222,10 @@ func (c *Client) PasswordResetInstanceDisk(ctx context.Context, linodeID int, di
 	}
 	e = fmt.Sprintf("%s/%d/password", e, diskID)
 
-	req := c.R(ctx).SetResult(&InstanceDisk{})
-	updateOpts := map[string]interface{}{
-		"password": password,
-	}
+	req := c.request(ctx, "PasswordResetInstanceDisk").SetResult(&InstanceDisk{})
+	updateOpts := instanceDiskPasswordResetOptions{Password: password}
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return NewError(err)
@@ -246,6 +246,6 @@ func (c *Client) DeleteInstanceDisk(ctx context.Context, linodeID int, diskID in
 	}
 	e = fmt.Sprintf("%s/%d", e, diskID)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteInstanceDisk").Delete(e))
 	return err
 }
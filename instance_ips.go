@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -64,7 +63,7 @@ func (c *Client) GetInstanceIPAddresses(ctx context.Context, linodeID int) (*Ins
 	if err != nil {
 		return nil, err
 	}
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceIPAddressResponse{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceIPAddresses").SetResult(&InstanceIPAddressResponse{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +77,7 @@ func (c *Client) GetInstanceIPAddress(ctx context.Context, linodeID int, ipaddre
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, ipaddress)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceIP{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceIPAddress").SetResult(&InstanceIP{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -93,14 +92,14 @@ func (c *Client) AddInstanceIPAddress(ctx context.Context, linodeID int, public
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&InstanceIP{})
+	req := c.request(ctx, "AddInstanceIPAddress").SetResult(&InstanceIP{})
 
 	instanceipRequest := struct {
 		Type   string `json:"type"`
 		Public bool   `json:"public"`
 	}{"ipv4", public}
 
-	if bodyData, err := json.Marshal(instanceipRequest); err == nil {
+	if bodyData, err := c.marshalBody(ctx, instanceipRequest); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -127,9 +126,9 @@ func (c *Client) UpdateInstanceIPAddress(ctx context.Context, linodeID int, ipAd
 	}
 	e = fmt.Sprintf("%s/%s", e, ipAddress)
 
-	req := c.R(ctx).SetResult(&InstanceIP{})
+	req := c.request(ctx, "UpdateInstanceIPAddress").SetResult(&InstanceIP{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
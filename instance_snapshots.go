@@ -77,7 +77,7 @@ func (c *Client) GetInstanceSnapshot(ctx context.Context, linodeID int, snapshot
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, snapshotID)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceSnapshot{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceSnapshot").SetResult(&InstanceSnapshot{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (c *Client) CreateInstanceSnapshot(ctx context.Context, linodeID int, label
 		return nil, err
 	}
 
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "CreateInstanceSnapshot").
 		SetBody(body).
 		SetResult(&InstanceSnapshot{}).
 		Post(e))
@@ -115,7 +115,7 @@ func (c *Client) GetInstanceBackups(ctx context.Context, linodeID int) (*Instanc
 	if err != nil {
 		return nil, err
 	}
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceBackups").
 		SetResult(&InstanceBackupsResponse{}).
 		Get(e))
 	if err != nil {
@@ -132,7 +132,7 @@ func (c *Client) EnableInstanceBackups(ctx context.Context, linodeID int) error
 	}
 	e = fmt.Sprintf("%s/enable", e)
 
-	_, err = coupleAPIErrors(c.R(ctx).Post(e))
+	_, err = coupleAPIErrors(c.request(ctx, "EnableInstanceBackups").Post(e))
 	return err
 }
 
@@ -144,13 +144,13 @@ func (c *Client) CancelInstanceBackups(ctx context.Context, linodeID int) error
 	}
 	e = fmt.Sprintf("%s/cancel", e)
 
-	_, err = coupleAPIErrors(c.R(ctx).Post(e))
+	_, err = coupleAPIErrors(c.request(ctx, "CancelInstanceBackups").Post(e))
 	return err
 }
 
 // RestoreInstanceBackup Restores a Linode's Backup to the specified Linode.
 func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backupID int, opts RestoreInstanceOptions) error {
-	o, err := json.Marshal(opts)
+	o, err := c.marshalBody(ctx, opts)
 	if err != nil {
 		return NewError(err)
 	}
@@ -161,7 +161,7 @@ func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backup
 	}
 	e = fmt.Sprintf("%s/%d/restore", e, backupID)
 
-	_, err = coupleAPIErrors(c.R(ctx).SetBody(body).Post(e))
+	_, err = coupleAPIErrors(c.request(ctx, "RestoreInstanceBackup").SetBody(body).Post(e))
 
 	return err
 
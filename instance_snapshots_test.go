@@ -3,6 +3,7 @@ package linodego_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/linode/linodego"
 )
@@ -42,7 +43,7 @@ func TestListInstanceBackups(t *testing.T) {
 		t.Errorf("Expected snapshot did not match current snapshot: %v", backups.Snapshot.Current)
 	}
 
-	_, err = client.WaitForSnapshotStatus(context.Background(), instance.ID, backup.ID, linodego.SnapshotSuccessful, 180)
+	_, err = client.WaitForSnapshotStatus(context.Background(), instance.ID, backup.ID, linodego.SnapshotSuccessful, 180*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for snapshot: %v", err)
 	}
@@ -70,7 +71,7 @@ func setupInstanceBackup(t *testing.T, fixturesYaml string) (*linodego.Client, *
 		t.Errorf("Error creating instance, got error %v", err)
 	}
 
-	client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180)
+	client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180*time.Second)
 	createOpts := linodego.InstanceDiskCreateOptions{
 		Size:       1,
 		Label:      "snapshot-linodego-testing",
@@ -82,7 +83,7 @@ func setupInstanceBackup(t *testing.T, fixturesYaml string) (*linodego.Client, *
 	}
 
 	// wait for disk to finish provisioning
-	event, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate, disk.Created, 240)
+	event, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate, disk.Created, 240*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for instance snapshot: %v", err)
 	}
@@ -101,7 +102,7 @@ func setupInstanceBackup(t *testing.T, fixturesYaml string) (*linodego.Client, *
 		t.Errorf("Error creating instance snapshot: %v", err)
 	}
 
-	event, err = client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeSnapshot, *instance.Created, 240)
+	event, err = client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeSnapshot, *instance.Created, 240*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for instance snapshot: %v", err)
 	}
@@ -34,14 +34,14 @@ type InstanceStats struct {
 }
 
 // endpointWithIDAndDate gets the endpoint URL for InstanceStats of a given Instance and Year/Month
-func endpointWithIDAndDate(c *Client, id int, year int, month int) string {
+func endpointWithIDAndDate(c *Client, id int, year int, month int) (string, error) {
 	endpoint, err := c.InstanceStats.endpointWithID(id)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 
 	endpoint = fmt.Sprintf("%s/%d/%d", endpoint, year, month)
-	return endpoint
+	return endpoint, nil
 }
 
 // GetInstanceStats gets the template with the provided ID
@@ -50,7 +50,7 @@ func (c *Client) GetInstanceStats(ctx context.Context, linodeID int) (*InstanceS
 	if err != nil {
 		return nil, err
 	}
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceStats{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceStats").SetResult(&InstanceStats{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,11 @@ func (c *Client) GetInstanceStats(ctx context.Context, linodeID int) (*InstanceS
 
 // GetInstanceStatsByDate gets the template with the provided ID, year, and month
 func (c *Client) GetInstanceStatsByDate(ctx context.Context, linodeID int, year int, month int) (*InstanceStats, error) {
-	e := endpointWithIDAndDate(c, linodeID, year, month)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceStats{}).Get(e))
+	e, err := endpointWithIDAndDate(c, linodeID, year, month)
+	if err != nil {
+		return nil, err
+	}
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceStatsByDate").SetResult(&InstanceStats{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -10,24 +10,15 @@ type InstanceVolumesPagedResponse struct {
 	Data []Volume `json:"data"`
 }
 
-// endpoint gets the endpoint URL for InstanceVolume
-func (InstanceVolumesPagedResponse) endpointWithID(c *Client, id int) string {
-	endpoint, err := c.InstanceVolumes.endpointWithID(id)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends InstanceVolumes when processing paginated InstanceVolume responses
-func (resp *InstanceVolumesPagedResponse) appendData(r *InstanceVolumesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *InstanceVolumesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*InstanceVolumesPagedResponse).Data...)
 }
 
 // ListInstanceVolumes lists InstanceVolumes
 func (c *Client) ListInstanceVolumes(ctx context.Context, linodeID int, opts *ListOptions) ([]Volume, error) {
 	response := InstanceVolumesPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, linodeID, opts)
+	err := c.listHelperWithID(ctx, "ListInstanceVolumes", &response, linodeID, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -36,3 +27,10 @@ func (c *Client) ListInstanceVolumes(ctx context.Context, linodeID int, opts *Li
 	}
 	return response.Data, nil
 }
+
+// ListInstanceVolumesFunc calls fn with each Volume, fetching pages as they are needed
+func (c *Client) ListInstanceVolumesFunc(ctx context.Context, linodeID int, opts *ListOptions, fn func(Volume) error) error {
+	return c.listFunc(ctx, "ListInstanceVolumesFunc", &InstanceVolumesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Volume).fixDates())
+	}, linodeID)
+}
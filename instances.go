@@ -99,7 +99,7 @@ type InstanceCreateOptions struct {
 	Type            string            `json:"type"`
 	Label           string            `json:"label,omitempty"`
 	Group           string            `json:"group,omitempty"`
-	RootPass        string            `json:"root_pass,omitempty"`
+	RootPass        string            `json:"root_pass,omitempty" redact:"true"`
 	AuthorizedKeys  []string          `json:"authorized_keys,omitempty"`
 	AuthorizedUsers []string          `json:"authorized_users,omitempty"`
 	StackScriptID   int               `json:"stackscript_id,omitempty"`
@@ -171,24 +171,15 @@ type InstancesPagedResponse struct {
 	Data []Instance `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Instance
-func (InstancesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Instances.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Instances when processing paginated Instance responses
-func (resp *InstancesPagedResponse) appendData(r *InstancesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *InstancesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*InstancesPagedResponse).Data...)
 }
 
 // ListInstances lists linode instances
 func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instance, error) {
 	response := InstancesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListInstances", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -198,6 +189,13 @@ func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instan
 	return response.Data, nil
 }
 
+// ListInstancesFunc calls fn with each Instance, fetching pages as they are needed
+func (c *Client) ListInstancesFunc(ctx context.Context, opts *ListOptions, fn func(Instance) error) error {
+	return c.listFunc(ctx, "ListInstancesFunc", &InstancesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Instance).fixDates())
+	})
+}
+
 // GetInstance gets the instance with the provided ID
 func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, error) {
 	e, err := c.Instances.Endpoint()
@@ -205,7 +203,7 @@ func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, erro
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, linodeID)
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstance").
 		SetResult(Instance{}).
 		Get(e))
 	if err != nil {
@@ -221,7 +219,7 @@ func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*Instan
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d/transfer", e, linodeID)
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetInstanceTransfer").
 		SetResult(InstanceTransfer{}).
 		Get(e))
 	if err != nil {
@@ -238,9 +236,9 @@ func (c *Client) CreateInstance(ctx context.Context, instance InstanceCreateOpti
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Instance{})
+	req := c.request(ctx, "CreateInstance").SetResult(&Instance{})
 
-	if bodyData, err := json.Marshal(instance); err == nil {
+	if bodyData, err := c.marshalBody(ctx, instance); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -265,9 +263,9 @@ func (c *Client) UpdateInstance(ctx context.Context, id int, instance InstanceUp
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&Instance{})
+	req := c.request(ctx, "UpdateInstance").SetResult(&Instance{})
 
-	if bodyData, err := json.Marshal(instance); err == nil {
+	if bodyData, err := c.marshalBody(ctx, instance); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -296,7 +294,7 @@ func (c *Client) DeleteInstance(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteInstance").Delete(e))
 	return err
 }
 
@@ -320,7 +318,7 @@ func (c *Client) BootInstance(ctx context.Context, id int, configID int) error {
 	}
 
 	e = fmt.Sprintf("%s/%d/boot", e, id)
-	_, err = coupleAPIErrors(c.R(ctx).
+	_, err = coupleAPIErrors(c.request(ctx, "BootInstance").
 		SetBody(bodyStr).
 		Post(e))
 
@@ -336,9 +334,9 @@ func (c *Client) CloneInstance(ctx context.Context, id int, options InstanceClon
 	}
 	e = fmt.Sprintf("%s/%d/clone", e, id)
 
-	req := c.R(ctx).SetResult(&Instance{})
+	req := c.request(ctx, "CloneInstance").SetResult(&Instance{})
 
-	if bodyData, err := json.Marshal(options); err == nil {
+	if bodyData, err := c.marshalBody(ctx, options); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -376,7 +374,7 @@ func (c *Client) RebootInstance(ctx context.Context, id int, configID int) error
 
 	e = fmt.Sprintf("%s/%d/reboot", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).
+	_, err = coupleAPIErrors(c.request(ctx, "RebootInstance").
 		SetBody(bodyStr).
 		Post(e))
 
@@ -386,7 +384,7 @@ func (c *Client) RebootInstance(ctx context.Context, id int, configID int) error
 // InstanceRebuildOptions is a struct representing the options to send to the rebuild linode endpoint
 type InstanceRebuildOptions struct {
 	Image           string            `json:"image"`
-	RootPass        string            `json:"root_pass"`
+	RootPass        string            `json:"root_pass" redact:"true"`
 	AuthorizedKeys  []string          `json:"authorized_keys"`
 	AuthorizedUsers []string          `json:"authorized_users"`
 	StackscriptID   int               `json:"stackscript_id"`
@@ -397,7 +395,7 @@ type InstanceRebuildOptions struct {
 // RebuildInstance Deletes all Disks and Configs on this Linode,
 // then deploys a new Image to this Linode with the given attributes.
 func (c *Client) RebuildInstance(ctx context.Context, id int, opts InstanceRebuildOptions) (*Instance, error) {
-	o, err := json.Marshal(opts)
+	o, err := c.marshalBody(ctx, opts)
 	if err != nil {
 		return nil, NewError(err)
 	}
@@ -407,7 +405,7 @@ func (c *Client) RebuildInstance(ctx context.Context, id int, opts InstanceRebui
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d/rebuild", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "RebuildInstance").
 		SetBody(b).
 		SetResult(&Instance{}).
 		Post(e))
@@ -427,7 +425,7 @@ type InstanceRescueOptions struct {
 // You can also use Rescue Mode for tasks other than disaster recovery, such as formatting disks to use different filesystems,
 // copying data between disks, and downloading files from a disk via SSH and SFTP.
 func (c *Client) RescueInstance(ctx context.Context, id int, opts InstanceRescueOptions) error {
-	o, err := json.Marshal(opts)
+	o, err := c.marshalBody(ctx, opts)
 	if err != nil {
 		return NewError(err)
 	}
@@ -438,7 +436,7 @@ func (c *Client) RescueInstance(ctx context.Context, id int, opts InstanceRescue
 	}
 	e = fmt.Sprintf("%s/%d/rescue", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).
+	_, err = coupleAPIErrors(c.request(ctx, "RescueInstance").
 		SetBody(b).
 		Post(e))
 
@@ -447,7 +445,7 @@ func (c *Client) RescueInstance(ctx context.Context, id int, opts InstanceRescue
 
 // ResizeInstance resizes an instance to new Linode type
 func (c *Client) ResizeInstance(ctx context.Context, id int, opts InstanceResizeOptions) error {
-	o, err := json.Marshal(opts)
+	o, err := c.marshalBody(ctx, opts)
 	if err != nil {
 		return NewError(err)
 	}
@@ -458,7 +456,7 @@ func (c *Client) ResizeInstance(ctx context.Context, id int, opts InstanceResize
 	}
 	e = fmt.Sprintf("%s/%d/resize", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).
+	_, err = coupleAPIErrors(c.request(ctx, "ResizeInstance").
 		SetBody(body).
 		Post(e))
 
@@ -467,27 +465,28 @@ func (c *Client) ResizeInstance(ctx context.Context, id int, opts InstanceResize
 
 // ShutdownInstance - Shutdown an instance
 func (c *Client) ShutdownInstance(ctx context.Context, id int) error {
-	return c.simpleInstanceAction(ctx, "shutdown", id)
+	return c.simpleInstanceAction(ctx, "ShutdownInstance", "shutdown", id)
 }
 
 // MutateInstance Upgrades a Linode to its next generation.
 func (c *Client) MutateInstance(ctx context.Context, id int) error {
-	return c.simpleInstanceAction(ctx, "mutate", id)
+	return c.simpleInstanceAction(ctx, "MutateInstance", "mutate", id)
 }
 
 // MigrateInstance - Migrate an instance
 func (c *Client) MigrateInstance(ctx context.Context, id int) error {
-	return c.simpleInstanceAction(ctx, "migrate", id)
+	return c.simpleInstanceAction(ctx, "MigrateInstance", "migrate", id)
 }
 
 // simpleInstanceAction is a helper for Instance actions that take no parameters
-// and return empty responses `{}` unless they return a standard error
-func (c *Client) simpleInstanceAction(ctx context.Context, action string, id int) error {
+// and return empty responses `{}` unless they return a standard error. The
+// operation is the name of the Client method calling it.
+func (c *Client) simpleInstanceAction(ctx context.Context, operation, action string, id int) error {
 	e, err := c.Instances.Endpoint()
 	if err != nil {
 		return err
 	}
 	e = fmt.Sprintf("%s/%d/%s", e, id, action)
-	_, err = coupleAPIErrors(c.R(ctx).Post(e))
+	_, err = coupleAPIErrors(c.request(ctx, operation).Post(e))
 	return err
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/linode/linodego"
 )
@@ -85,7 +86,7 @@ func TestResizeInstanceDisk(t *testing.T) {
 		t.Error(err)
 	}
 
-	instance, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180)
+	instance, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for instance readiness for resize: %s", err)
 	}
@@ -99,7 +100,7 @@ func TestResizeInstanceDisk(t *testing.T) {
 		t.Errorf("Error creating disk for resize: %s", err)
 	}
 
-	disk, err = client.WaitForInstanceDiskStatus(context.Background(), instance.ID, disk.ID, linodego.DiskReady, 180)
+	disk, err = client.WaitForInstanceDiskStatus(context.Background(), instance.ID, disk.ID, linodego.DiskReady, 180*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for disk readiness for resize: %s", err)
 	}
@@ -120,7 +121,7 @@ func TestPasswordResetInstanceDisk(t *testing.T) {
 		t.Error(err)
 	}
 
-	instance, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180)
+	instance, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for instance readiness for password reset: %s", err)
 	}
@@ -136,11 +137,11 @@ func TestPasswordResetInstanceDisk(t *testing.T) {
 		t.Errorf("Error creating disk for password reset: %s", err)
 	}
 
-	instance, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180)
+	instance, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, 180*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for instance readiness after creating disk for password reset: %s", err)
 	}
-	disk, err = client.WaitForInstanceDiskStatus(context.Background(), instance.ID, disk.ID, linodego.DiskReady, 180)
+	disk, err = client.WaitForInstanceDiskStatus(context.Background(), instance.ID, disk.ID, linodego.DiskReady, 180*time.Second)
 	if err != nil {
 		t.Errorf("Error waiting for disk readiness for password reset: %s", err)
 	}
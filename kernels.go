@@ -25,23 +25,22 @@ type LinodeKernelsPagedResponse struct {
 // ListKernels lists linode kernels
 func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKernel, error) {
 	response := LinodeKernelsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListKernels", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
-func (LinodeKernelsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Kernels.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
+// ListKernelsFunc calls fn with each LinodeKernel, fetching pages as they are needed
+func (c *Client) ListKernelsFunc(ctx context.Context, opts *ListOptions, fn func(LinodeKernel) error) error {
+	return c.listFunc(ctx, "ListKernelsFunc", &LinodeKernelsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*LinodeKernel))
+	})
 }
 
-func (resp *LinodeKernelsPagedResponse) appendData(r *LinodeKernelsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *LinodeKernelsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*LinodeKernelsPagedResponse).Data...)
 }
 
 // GetKernel gets the kernel with the provided ID
@@ -51,9 +50,9 @@ func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel,
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, kernelID)
-	r, err := c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetKernel").
 		SetResult(&LinodeKernel{}).
-		Get(e)
+		Get(e))
 	if err != nil {
 		return nil, err
 	}
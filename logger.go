@@ -0,0 +1,125 @@
+package linodego
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+)
+
+// Logger receives the messages logged by a Client, such as the progress of the
+// WaitFor functions and, when debugging is enabled, the requests and responses
+// exchanged with the API. Each message may be followed by alternating keys and
+// values describing it.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// SetLogger sets the Logger receiving the Client's messages. Use nil to restore
+// the default, which discards all messages unless debugging is enabled (see
+// SetDebug), in which case they are written by the standard log package.
+func (c *Client) SetLogger(logger Logger) *Client {
+	c.transport.update(func(s *transportSettings) { s.logger = logger })
+	return c
+}
+
+// logger returns the Logger receiving the Client's messages
+func (c *Client) logger() Logger {
+	return c.transport.current().getLogger()
+}
+
+func (s transportSettings) getLogger() Logger {
+	if s.logger != nil {
+		return s.logger
+	}
+	if s.debug {
+		return stdLogger{}
+	}
+	return noopLogger{}
+}
+
+// noopLogger discards all messages
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
+
+// NewStdLogger returns a Logger writing to l, or to the standard logger when l
+// is nil. Messages are prefixed by their level, such as "[INFO]", and followed
+// by their keys and values formatted as key=value.
+func NewStdLogger(l *log.Logger) Logger {
+	return stdLogger{l}
+}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+func (s stdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	s.print("DEBUG", msg, keysAndValues)
+}
+
+func (s stdLogger) Info(msg string, keysAndValues ...interface{}) {
+	s.print("INFO", msg, keysAndValues)
+}
+
+func (s stdLogger) Warn(msg string, keysAndValues ...interface{}) {
+	s.print("WARN", msg, keysAndValues)
+}
+
+func (s stdLogger) Error(msg string, keysAndValues ...interface{}) {
+	s.print("ERROR", msg, keysAndValues)
+}
+
+func (s stdLogger) print(level string, msg string, keysAndValues []interface{}) {
+	line := formatLogMessage(level, msg, keysAndValues)
+	if s.l == nil {
+		log.Print(line)
+		return
+	}
+	s.l.Print(line)
+}
+
+// formatLogMessage formats a message as "[LEVEL] msg key=value ..."
+func formatLogMessage(level string, msg string, keysAndValues []interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v=(MISSING)", keysAndValues[i])
+		}
+	}
+	return b.String()
+}
+
+// restyLogTimestamp matches the timestamp resty adds to some of its messages
+var restyLogTimestamp = regexp.MustCompile(`^\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2} `)
+
+// restyLogWriter routes the output of resty's logger, including its debug
+// output, to the Client's Logger
+type restyLogWriter struct {
+	transport *apiTransport
+}
+
+// Write implements io.Writer
+func (w restyLogWriter) Write(p []byte) (int, error) {
+	msg := redact(strings.TrimSpace(restyLogTimestamp.ReplaceAllString(string(p), "")))
+	logger := w.transport.current().getLogger()
+
+	switch {
+	case strings.HasPrefix(msg, "ERROR"), strings.HasPrefix(msg, "ERORR"):
+		logger.Error(msg)
+	case strings.HasPrefix(msg, "WARNING"):
+		logger.Warn(msg)
+	default:
+		logger.Debug(msg)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,114 @@
+package linodego_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/linode/linodego"
+)
+
+type testLogEntry struct {
+	level         string
+	msg           string
+	keysAndValues []interface{}
+}
+
+// testLogger records the messages it receives
+type testLogger struct {
+	mu      sync.Mutex
+	entries []testLogEntry
+}
+
+func (l *testLogger) record(level string, msg string, keysAndValues []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, testLogEntry{level, msg, keysAndValues})
+}
+
+func (l *testLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.record("debug", msg, keysAndValues)
+}
+
+func (l *testLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.record("info", msg, keysAndValues)
+}
+
+func (l *testLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.record("warn", msg, keysAndValues)
+}
+
+func (l *testLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.record("error", msg, keysAndValues)
+}
+
+// output returns every recorded message, with its keys and values
+func (l *testLogger) output() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var b strings.Builder
+	for _, e := range l.entries {
+		fmt.Fprintf(&b, "%s %s %v\n", e.level, e.msg, e.keysAndValues)
+	}
+	return b.String()
+}
+
+func typesHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"page": 1, "pages": 1, "results": 1, "data": [{"id": "g6-nanode-1"}]}`))
+	})
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewStdLogger(log.New(&buf, "", 0))
+
+	logger.Info("Waiting for event", "entity_id", 123, "action", "linode_boot")
+	logger.Warn("Odd key", "dangling")
+
+	expected := "[INFO] Waiting for event entity_id=123 action=linode_boot\n[WARN] Odd key dangling=(MISSING)\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestSetLogger_debug(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, typesHandler())
+	defer teardown()
+
+	logger := &testLogger{}
+	client.SetLogger(logger).SetDebug(true)
+
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing types, got %v", err)
+	}
+
+	output := logger.output()
+	if !strings.Contains(output, "debug") || !strings.Contains(output, "/linode/types") || !strings.Contains(output, "g6-nanode-1") {
+		t.Errorf("Expected the request and response to be logged at the debug level, got %q", output)
+	}
+}
+
+func TestSetLogger_defaultIsSilent(t *testing.T) {
+	var buf bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&buf)
+
+	client, teardown := createHTTPTestClient(t, typesHandler())
+	defer teardown()
+	client.SetDebug(false)
+
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing types, got %v", err)
+	}
+	if buf.Len() > 0 {
+		t.Errorf("Expected nothing to be logged by default, got %q", buf.String())
+	}
+}
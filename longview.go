@@ -18,24 +18,15 @@ type LongviewClientsPagedResponse struct {
 	Data []LongviewClient `json:"data"`
 }
 
-// endpoint gets the endpoint URL for LongviewClient
-func (LongviewClientsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.LongviewClients.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends LongviewClients when processing paginated LongviewClient responses
-func (resp *LongviewClientsPagedResponse) appendData(r *LongviewClientsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *LongviewClientsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*LongviewClientsPagedResponse).Data...)
 }
 
 // ListLongviewClients lists LongviewClients
 func (c *Client) ListLongviewClients(ctx context.Context, opts *ListOptions) ([]LongviewClient, error) {
 	response := LongviewClientsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListLongviewClients", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -45,6 +36,13 @@ func (c *Client) ListLongviewClients(ctx context.Context, opts *ListOptions) ([]
 	return response.Data, nil
 }
 
+// ListLongviewClientsFunc calls fn with each LongviewClient, fetching pages as they are needed
+func (c *Client) ListLongviewClientsFunc(ctx context.Context, opts *ListOptions, fn func(LongviewClient) error) error {
+	return c.listFunc(ctx, "ListLongviewClientsFunc", &LongviewClientsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*LongviewClient).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *LongviewClient) fixDates() *LongviewClient {
 	// v.Created, _ = parseDates(v.CreatedStr)
@@ -59,7 +57,7 @@ func (c *Client) GetLongviewClient(ctx context.Context, id string) (*LongviewCli
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := c.R(ctx).SetResult(&LongviewClient{}).Get(e)
+	r, err := coupleAPIErrors(c.request(ctx, "GetLongviewClient").SetResult(&LongviewClient{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
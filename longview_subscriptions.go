@@ -21,24 +21,15 @@ type LongviewSubscriptionsPagedResponse struct {
 	Data []LongviewSubscription `json:"data"`
 }
 
-// endpoint gets the endpoint URL for LongviewSubscription
-func (LongviewSubscriptionsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.LongviewSubscriptions.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends LongviewSubscriptions when processing paginated LongviewSubscription responses
-func (resp *LongviewSubscriptionsPagedResponse) appendData(r *LongviewSubscriptionsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *LongviewSubscriptionsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*LongviewSubscriptionsPagedResponse).Data...)
 }
 
 // ListLongviewSubscriptions lists LongviewSubscriptions
 func (c *Client) ListLongviewSubscriptions(ctx context.Context, opts *ListOptions) ([]LongviewSubscription, error) {
 	response := LongviewSubscriptionsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListLongviewSubscriptions", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -48,6 +39,13 @@ func (c *Client) ListLongviewSubscriptions(ctx context.Context, opts *ListOption
 	return response.Data, nil
 }
 
+// ListLongviewSubscriptionsFunc calls fn with each LongviewSubscription, fetching pages as they are needed
+func (c *Client) ListLongviewSubscriptionsFunc(ctx context.Context, opts *ListOptions, fn func(LongviewSubscription) error) error {
+	return c.listFunc(ctx, "ListLongviewSubscriptionsFunc", &LongviewSubscriptionsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*LongviewSubscription).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *LongviewSubscription) fixDates() *LongviewSubscription {
 	// v.Created, _ = parseDates(v.CreatedStr)
@@ -62,7 +60,7 @@ func (c *Client) GetLongviewSubscription(ctx context.Context, id string) (*Longv
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := c.R(ctx).SetResult(&LongviewSubscription{}).Get(e)
+	r, err := coupleAPIErrors(c.request(ctx, "GetLongviewSubscription").SetResult(&LongviewSubscription{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
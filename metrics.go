@@ -0,0 +1,281 @@
+package linodego
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MetricLabels identify the requests measured by a MetricsCollector
+type MetricLabels struct {
+	// Resource is the name of the requested Resource, such as "instances"
+	Resource string
+	// Method is the HTTP method of the request
+	Method string
+}
+
+// MetricsCollector receives measurements of the requests made by a Client
+type MetricsCollector interface {
+	// RequestCompleted is called once for each request, after any retries. The
+	// statusCode is 0 when no response was received.
+	RequestCompleted(labels MetricLabels, statusCode int, duration time.Duration)
+
+	// RequestRetried is called before each retry of a request
+	RequestRetried(labels MetricLabels)
+
+	// RateLimitWaited is called when a request was delayed by the client-side rate limiter
+	RateLimitWaited(labels MetricLabels, wait time.Duration)
+}
+
+// SetMetricsCollector sets the MetricsCollector measuring the Client's requests.
+// Use nil to stop collecting metrics.
+func (c *Client) SetMetricsCollector(collector MetricsCollector) *Client {
+	c.transport.update(func(s *transportSettings) { s.metrics = collector })
+	return c
+}
+
+// requestLabels returns the MetricLabels of the request
+func requestLabels(req *http.Request) MetricLabels {
+	if call := requestCallFromContext(req.Context()); call != nil {
+		return MetricLabels{Resource: call.info.Resource, Method: call.info.Method}
+	}
+	return MetricLabels{Method: req.Method}
+}
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency
+// histogram buckets used by NewInMemoryMetrics when none are given
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// LatencyHistogram counts request durations in buckets
+type LatencyHistogram struct {
+	// Buckets are the upper bounds of the buckets, in seconds
+	Buckets []float64
+	// Counts are the number of durations less than or equal to each bucket's bound
+	Counts []uint64
+	// Count is the number of durations observed
+	Count uint64
+	// Sum is the total of the durations observed, in seconds
+	Sum float64
+}
+
+func (h *LatencyHistogram) observe(d time.Duration) {
+	seconds := d.Seconds()
+	for i, bound := range h.Buckets {
+		if seconds <= bound {
+			h.Counts[i]++
+		}
+	}
+	h.Count++
+	h.Sum += seconds
+}
+
+// RequestMetrics are the measurements of requests sharing the same MetricLabels
+type RequestMetrics struct {
+	// Requests is the number of requests made
+	Requests uint64
+	// Errors is the number of requests failing with each status code. Requests
+	// which received no response are counted with a status of 0.
+	Errors map[int]uint64
+	// Retries is the number of retries
+	Retries uint64
+	// RateLimitWaits is the number of requests delayed by the rate limiter
+	RateLimitWaits uint64
+	// RateLimitWaitTime is the total delay caused by the rate limiter
+	RateLimitWaitTime time.Duration
+	// Latency is the distribution of request durations
+	Latency LatencyHistogram
+}
+
+// InMemoryMetrics is a MetricsCollector keeping its measurements in memory.
+// It can write them in the Prometheus text exposition format, and serve them
+// as an http.Handler.
+type InMemoryMetrics struct {
+	mu      sync.Mutex
+	buckets []float64
+	metrics map[MetricLabels]*RequestMetrics
+}
+
+var _ MetricsCollector = (*InMemoryMetrics)(nil)
+
+// NewInMemoryMetrics returns an InMemoryMetrics using the given latency bucket
+// bounds, in seconds, or DefaultLatencyBuckets when none are given
+func NewInMemoryMetrics(buckets ...float64) *InMemoryMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+	return &InMemoryMetrics{buckets: sorted, metrics: make(map[MetricLabels]*RequestMetrics)}
+}
+
+// get returns the metrics for the labels. The caller must hold the lock.
+func (m *InMemoryMetrics) get(labels MetricLabels) *RequestMetrics {
+	rm, ok := m.metrics[labels]
+	if !ok {
+		rm = &RequestMetrics{
+			Errors: make(map[int]uint64),
+			Latency: LatencyHistogram{
+				Buckets: m.buckets,
+				Counts:  make([]uint64, len(m.buckets)),
+			},
+		}
+		m.metrics[labels] = rm
+	}
+	return rm
+}
+
+// RequestCompleted implements MetricsCollector
+func (m *InMemoryMetrics) RequestCompleted(labels MetricLabels, statusCode int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rm := m.get(labels)
+	rm.Requests++
+	rm.Latency.observe(duration)
+	if statusCode == 0 || statusCode >= 400 {
+		rm.Errors[statusCode]++
+	}
+}
+
+// RequestRetried implements MetricsCollector
+func (m *InMemoryMetrics) RequestRetried(labels MetricLabels) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.get(labels).Retries++
+}
+
+// RateLimitWaited implements MetricsCollector
+func (m *InMemoryMetrics) RateLimitWaited(labels MetricLabels, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rm := m.get(labels)
+	rm.RateLimitWaits++
+	rm.RateLimitWaitTime += wait
+}
+
+// Snapshot returns a copy of the measurements collected so far
+func (m *InMemoryMetrics) Snapshot() map[MetricLabels]RequestMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[MetricLabels]RequestMetrics, len(m.metrics))
+	for labels, rm := range m.metrics {
+		c := *rm
+		c.Errors = make(map[int]uint64, len(rm.Errors))
+		for status, count := range rm.Errors {
+			c.Errors[status] = count
+		}
+		c.Latency.Counts = append([]uint64{}, rm.Latency.Counts...)
+		snapshot[labels] = c
+	}
+	return snapshot
+}
+
+// Reset discards the measurements collected so far
+func (m *InMemoryMetrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metrics = make(map[MetricLabels]*RequestMetrics)
+}
+
+// WritePrometheus writes the measurements in the Prometheus text exposition format
+func (m *InMemoryMetrics) WritePrometheus(w io.Writer) error {
+	snapshot := m.Snapshot()
+
+	keys := make([]MetricLabels, 0, len(snapshot))
+	for labels := range snapshot {
+		keys = append(keys, labels)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Resource != keys[j].Resource {
+			return keys[i].Resource < keys[j].Resource
+		}
+		return keys[i].Method < keys[j].Method
+	})
+
+	bw := bufio.NewWriter(w)
+	family := func(name string, kind string, help string) {
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	family("linodego_requests_total", "counter", "Requests made to the Linode API.")
+	for _, labels := range keys {
+		fmt.Fprintf(bw, "linodego_requests_total%s %d\n", promLabels(labels), snapshot[labels].Requests)
+	}
+
+	family("linodego_request_duration_seconds", "histogram", "Duration of requests made to the Linode API, including retries.")
+	for _, labels := range keys {
+		h := snapshot[labels].Latency
+		for i, bound := range h.Buckets {
+			fmt.Fprintf(bw, "linodego_request_duration_seconds_bucket%s %d\n", promLabels(labels, "le", formatFloat(bound)), h.Counts[i])
+		}
+		fmt.Fprintf(bw, "linodego_request_duration_seconds_bucket%s %d\n", promLabels(labels, "le", "+Inf"), h.Count)
+		fmt.Fprintf(bw, "linodego_request_duration_seconds_sum%s %s\n", promLabels(labels), formatFloat(h.Sum))
+		fmt.Fprintf(bw, "linodego_request_duration_seconds_count%s %d\n", promLabels(labels), h.Count)
+	}
+
+	family("linodego_request_errors_total", "counter", "Requests to the Linode API that failed, by status code (0 when no response was received).")
+	for _, labels := range keys {
+		errs := snapshot[labels].Errors
+		statuses := make([]int, 0, len(errs))
+		for status := range errs {
+			statuses = append(statuses, status)
+		}
+		sort.Ints(statuses)
+		for _, status := range statuses {
+			fmt.Fprintf(bw, "linodego_request_errors_total%s %d\n", promLabels(labels, "status", strconv.Itoa(status)), errs[status])
+		}
+	}
+
+	family("linodego_request_retries_total", "counter", "Retries of requests to the Linode API.")
+	for _, labels := range keys {
+		fmt.Fprintf(bw, "linodego_request_retries_total%s %d\n", promLabels(labels), snapshot[labels].Retries)
+	}
+
+	family("linodego_rate_limit_waits_total", "counter", "Requests delayed by the client-side rate limiter.")
+	for _, labels := range keys {
+		fmt.Fprintf(bw, "linodego_rate_limit_waits_total%s %d\n", promLabels(labels), snapshot[labels].RateLimitWaits)
+	}
+
+	family("linodego_rate_limit_wait_seconds_total", "counter", "Time requests were delayed by the client-side rate limiter.")
+	for _, labels := range keys {
+		fmt.Fprintf(bw, "linodego_rate_limit_wait_seconds_total%s %s\n", promLabels(labels), formatFloat(snapshot[labels].RateLimitWaitTime.Seconds()))
+	}
+
+	return bw.Flush()
+}
+
+// ServeHTTP implements http.Handler, serving the measurements in the Prometheus text exposition format
+func (m *InMemoryMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = m.WritePrometheus(w)
+}
+
+var promLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// promLabels formats the labels, followed by any extra label names and values
+func promLabels(labels MetricLabels, extra ...string) string {
+	pairs := append([]string{"resource", labels.Resource, "method", labels.Method}, extra...)
+
+	var b strings.Builder
+	b.WriteString("{")
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `%s="%s"`, pairs[i], promLabelEscaper.Replace(pairs[i+1]))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,103 @@
+package linodego_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+func TestInMemoryMetrics(t *testing.T) {
+	var typesRequests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/linode/instances/123") {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+		if atomic.AddInt32(&typesRequests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		typesHandler().ServeHTTP(w, r)
+	}))
+	defer teardown()
+
+	metrics := NewInMemoryMetrics()
+	client.SetMetricsCollector(metrics).
+		SetRetryPolicy(testRetryPolicy()).
+		SetRateLimits(map[string]RateLimit{http.MethodGet: {Requests: 1, Interval: 20 * time.Millisecond}})
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.ListTypes(context.Background(), nil); err != nil {
+			t.Fatalf("Error listing types, got %v", err)
+		}
+	}
+	if _, err := client.GetInstance(context.Background(), 123); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+
+	snapshot := metrics.Snapshot()
+
+	types := snapshot[MetricLabels{Resource: "types", Method: http.MethodGet}]
+	if types.Requests != 2 || types.Retries != 1 || len(types.Errors) != 0 {
+		t.Errorf("Expected 2 types requests with 1 retry and no errors, got %+v", types)
+	}
+	if types.RateLimitWaits == 0 || types.RateLimitWaitTime <= 0 {
+		t.Errorf("Expected types requests to wait for the rate limiter, got %+v", types)
+	}
+	if types.Latency.Count != 2 || types.Latency.Sum <= 0 {
+		t.Errorf("Expected 2 latencies to be observed, got %+v", types.Latency)
+	}
+
+	instances := snapshot[MetricLabels{Resource: "instances", Method: http.MethodGet}]
+	if instances.Requests != 1 || instances.Errors[http.StatusNotFound] != 1 {
+		t.Errorf("Expected 1 instances request failing with a 404, got %+v", instances)
+	}
+}
+
+func TestInMemoryMetrics_prometheus(t *testing.T) {
+	metrics := NewInMemoryMetrics(0.1, 1)
+	labels := MetricLabels{Resource: "instances", Method: http.MethodPost}
+	metrics.RequestCompleted(labels, http.StatusOK, 50*time.Millisecond)
+	metrics.RequestCompleted(labels, http.StatusTooManyRequests, 500*time.Millisecond)
+	metrics.RequestRetried(labels)
+	metrics.RateLimitWaited(labels, 2*time.Second)
+
+	var buf bytes.Buffer
+	if err := metrics.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		"# TYPE linodego_requests_total counter",
+		`linodego_requests_total{resource="instances",method="POST"} 2`,
+		"# TYPE linodego_request_duration_seconds histogram",
+		`linodego_request_duration_seconds_bucket{resource="instances",method="POST",le="0.1"} 1`,
+		`linodego_request_duration_seconds_bucket{resource="instances",method="POST",le="1"} 2`,
+		`linodego_request_duration_seconds_bucket{resource="instances",method="POST",le="+Inf"} 2`,
+		`linodego_request_duration_seconds_sum{resource="instances",method="POST"} 0.55`,
+		`linodego_request_duration_seconds_count{resource="instances",method="POST"} 2`,
+		`linodego_request_errors_total{resource="instances",method="POST",status="429"} 1`,
+		`linodego_request_retries_total{resource="instances",method="POST"} 1`,
+		`linodego_rate_limit_waits_total{resource="instances",method="POST"} 1`,
+		`linodego_rate_limit_wait_seconds_total{resource="instances",method="POST"} 2`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("Expected the exposition to contain %q, got:\n%s", line, buf.String())
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Body.String() != buf.String() || !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("Expected the handler to serve the exposition")
+	}
+}
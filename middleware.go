@@ -0,0 +1,210 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/resty.v1"
+)
+
+// RequestInfo describes a request made by a Client to the Linode API
+type RequestInfo struct {
+	// Method is the HTTP method of the request
+	Method string
+
+	// Resource is the name of the Resource whose endpoint was requested, such as
+	// "instances" or "nodebalancernodes". It is empty when no Resource matches.
+	Resource string
+
+	// Path is the requested path, relative to the API's base URL
+	Path string
+
+	// IDs are the IDs given in the path, in order, such as the Instance ID and
+	// Disk ID of "linode/instances/123/disks/456"
+	IDs []string
+
+	// Header holds the headers sent with the request. Headers set by
+	// OnBeforeRequest functions are included.
+	Header http.Header
+}
+
+// ResponseInfo describes the outcome of a request made by a Client
+type ResponseInfo struct {
+	*RequestInfo
+
+	// StatusCode is the HTTP status of the response, or 0 if none was received
+	StatusCode int
+
+	// Duration is the time taken by the request, including any retries
+	Duration time.Duration
+
+	// Error is the error returned for the request, if any
+	Error *Error
+}
+
+// BeforeRequestFunc is called before each request made by a Client. Returning an
+// error prevents the request from being sent, and the error is returned to the caller.
+type BeforeRequestFunc func(ctx context.Context, req *RequestInfo) error
+
+// AfterResponseFunc is called after each request made by a Client, whether or not it succeeded.
+// It is also called for requests rejected before being sent, such as by a BeforeRequestFunc.
+type AfterResponseFunc func(ctx context.Context, resp *ResponseInfo)
+
+// OnBeforeRequest adds a function called before each request. Functions are called
+// in the order they were added, stopping at the first to return an error.
+func (c *Client) OnBeforeRequest(fn BeforeRequestFunc) *Client {
+	c.transport.update(func(s *transportSettings) {
+		s.beforeRequest = append(s.beforeRequest[:len(s.beforeRequest):len(s.beforeRequest)], fn)
+	})
+	return c
+}
+
+// OnAfterResponse adds a function called after each request. Functions are called
+// in the order they were added.
+func (c *Client) OnAfterResponse(fn AfterResponseFunc) *Client {
+	c.transport.update(func(s *transportSettings) {
+		s.afterResponse = append(s.afterResponse[:len(s.afterResponse):len(s.afterResponse)], fn)
+	})
+	return c
+}
+
+type requestCallKey struct{}
+
+// requestCall follows a request from its OnBeforeRequest to its OnAfterResponse functions
+type requestCall struct {
+	info  *RequestInfo
+	start time.Time
+	span  *traceSpan
+
+	// once ensures the call is finished once, by whichever of beforeRequestHook,
+	// afterResponseHook, RoundTrip and the response body comes first
+	once     sync.Once
+	finished int32
+}
+
+// isFinished reports whether the call was finished
+func (c *requestCall) isFinished() bool {
+	return atomic.LoadInt32(&c.finished) == 1
+}
+
+// requestCallFromContext returns the requestCall carried by the context of a request, if any
+func requestCallFromContext(ctx context.Context) *requestCall {
+	call, _ := ctx.Value(requestCallKey{}).(*requestCall)
+	return call
+}
+
+// relativePath returns the path of the request URL relative to the base URL
+func relativePath(baseURL string, u *url.URL) string {
+	path := u.Path
+	if base, err := url.Parse(baseURL); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(base.Path, "/"))
+	}
+	return strings.Trim(path, "/")
+}
+
+// beforeRequestHook returns the resty pre-request hook starting each requestCall.
+// The resources are used to find the Resource of each request.
+func (t *apiTransport) beforeRequestHook(resources map[string]*Resource) func(*resty.Client, *resty.Request) error {
+	return func(rc *resty.Client, r *resty.Request) error {
+		raw := r.RawRequest
+		path := relativePath(rc.HostURL, raw.URL)
+		name, ids := resolveResource(resources, path)
+
+		call := &requestCall{
+			info: &RequestInfo{
+				Method:   raw.Method,
+				Resource: name,
+				Path:     path,
+				IDs:      ids,
+				Header:   raw.Header,
+			},
+			start: time.Now(),
+		}
+
+		s := t.current()
+		ctx := raw.Context()
+		if err := s.startCall(ctx, call, requestAPIVersion(ctx, rc.HostURL), resources[name]); err != nil {
+			// Rejected requests are finished too, they are never sent
+			s.finishCall(ctx, call, 0, nil, NewError(err))
+			return err
+		}
+		overrideAPIVersion(ctx, rc.HostURL, raw.URL)
+
+		ctx, call.span = s.startRequestSpan(ctx, call.info)
+		r.RawRequest = raw.WithContext(context.WithValue(ctx, requestCallKey{}, call))
+		return nil
+	}
+}
+
+// startCall checks the call may be sent, calling the OnBeforeRequest functions
+func (s transportSettings) startCall(ctx context.Context, call *requestCall, version string, resource *Resource) error {
+	if err := checkBeta(version, resource); err != nil {
+		return err
+	}
+	if check := s.scopeCheck; check != nil {
+		if err := check.check(ctx, call.info); err != nil {
+			return err
+		}
+	}
+	for _, fn := range s.beforeRequest {
+		if err := fn(ctx, call.info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// afterResponseHook is the resty response middleware finishing each requestCall
+// whose response was read and decoded. The other responses are finished when
+// their body is closed, see finishingBody.
+func (t *apiTransport) afterResponseHook(rc *resty.Client, r *resty.Response) error {
+	if r.Request == nil || r.Request.RawRequest == nil {
+		return nil
+	}
+
+	var err *Error
+	if r.IsError() {
+		err = NewError(r)
+	}
+	t.current().finishRequest(r.Request.RawRequest.Context(), r.StatusCode(), r.Body(), err)
+	return nil
+}
+
+// finishRequest finishes the requestCall in ctx, unless it was already finished
+func (s transportSettings) finishRequest(ctx context.Context, statusCode int, body []byte, err *Error) {
+	call := requestCallFromContext(ctx)
+	if call == nil {
+		return
+	}
+	s.finishCall(ctx, call, statusCode, body, err)
+}
+
+// finishCall records the metrics of the call, calls the OnAfterResponse functions
+// and ends its span, unless the call was already finished
+func (s transportSettings) finishCall(ctx context.Context, call *requestCall, statusCode int, body []byte, err *Error) {
+	call.once.Do(func() {
+		atomic.StoreInt32(&call.finished, 1)
+		s.completeCall(ctx, call, statusCode, body, err)
+	})
+}
+
+func (s transportSettings) completeCall(ctx context.Context, call *requestCall, statusCode int, body []byte, err *Error) {
+	resp := &ResponseInfo{
+		RequestInfo: call.info,
+		StatusCode:  statusCode,
+		Duration:    time.Since(call.start),
+		Error:       err,
+	}
+	if s.metrics != nil {
+		s.metrics.RequestCompleted(MetricLabels{Resource: call.info.Resource, Method: call.info.Method}, statusCode, resp.Duration)
+	}
+	for _, fn := range s.afterResponse {
+		fn(ctx, resp)
+	}
+	finishRequestSpan(call.span, statusCode, body, len(call.info.IDs) > 0, err)
+}
@@ -0,0 +1,181 @@
+package linodego_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/linode/linodego"
+)
+
+func TestOnBeforeRequest_headers(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Correlation-ID") != "abc-123" || r.Header.Get("X-Tenant") != "blue" {
+			t.Errorf("Expected the headers set by middleware, got %v", r.Header)
+		}
+		typesHandler().ServeHTTP(w, r)
+	}))
+	defer teardown()
+
+	var order []string
+	client.OnBeforeRequest(func(ctx context.Context, req *RequestInfo) error {
+		order = append(order, "first")
+		req.Header.Set("X-Correlation-ID", "abc-123")
+		return nil
+	}).OnBeforeRequest(func(ctx context.Context, req *RequestInfo) error {
+		order = append(order, "second")
+		if req.Header.Get("X-Correlation-ID") != "abc-123" {
+			t.Errorf("Expected middleware to see the headers set before it")
+		}
+		req.Header.Set("X-Tenant", "blue")
+		return nil
+	})
+
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing types, got %v", err)
+	}
+	if !reflect.DeepEqual(order, []string{"first", "second"}) {
+		t.Errorf("Expected middleware to be called in order, got %v", order)
+	}
+}
+
+func TestOnBeforeRequest_error(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(1, 1, &requests))
+	defer teardown()
+
+	denied := errors.New("denied")
+	client.OnBeforeRequest(func(ctx context.Context, req *RequestInfo) error {
+		return denied
+	})
+
+	_, err := client.ListEvents(context.Background(), nil)
+	if !errors.Is(err, denied) {
+		t.Errorf("Expected the middleware's error, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected the request not to be sent, got %d requests", requests)
+	}
+}
+
+func TestOnAfterResponse_rejected(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(1, 1, &requests))
+	defer teardown()
+
+	denied := errors.New("denied")
+	var responses []*ResponseInfo
+	client.OnBeforeRequest(func(ctx context.Context, req *RequestInfo) error {
+		return denied
+	}).OnAfterResponse(func(ctx context.Context, resp *ResponseInfo) {
+		responses = append(responses, resp)
+	})
+	metrics := NewInMemoryMetrics()
+	client.SetMetricsCollector(metrics)
+
+	if _, err := client.ListEvents(context.Background(), nil); !errors.Is(err, denied) {
+		t.Fatalf("Expected the middleware's error, got %v", err)
+	}
+	if len(responses) != 1 {
+		t.Fatalf("Expected 1 response, got %d", len(responses))
+	}
+	if resp := responses[0]; resp.StatusCode != 0 || !errors.Is(resp.Error, denied) || resp.Resource != "events" {
+		t.Errorf("Expected an events request without a response to have the middleware's error, got %d %v", resp.StatusCode, resp.Error)
+	}
+	if events := metrics.Snapshot()[MetricLabels{Resource: "events", Method: http.MethodGet}]; events.Requests != 1 {
+		t.Errorf("Expected 1 events request to be completed, got %+v", events)
+	}
+}
+
+func TestOnAfterResponse(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+	}))
+	defer teardown()
+
+	var responses []*ResponseInfo
+	client.OnAfterResponse(func(ctx context.Context, resp *ResponseInfo) {
+		responses = append(responses, resp)
+	})
+
+	if _, err := client.GetInstanceDisk(context.Background(), 123, 456); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+	if len(responses) != 1 {
+		t.Fatalf("Expected 1 response, got %d", len(responses))
+	}
+
+	resp := responses[0]
+	if resp.Method != http.MethodGet || resp.Resource != "disks" || resp.Path != "linode/instances/123/disks/456" {
+		t.Errorf("Unexpected request %s %s (%s)", resp.Method, resp.Path, resp.Resource)
+	}
+	if !reflect.DeepEqual(resp.IDs, []string{"123", "456"}) {
+		t.Errorf("Expected IDs 123 and 456, got %v", resp.IDs)
+	}
+	if resp.StatusCode != http.StatusNotFound || resp.Duration <= 0 {
+		t.Errorf("Expected a 404 status and a duration, got %d and %v", resp.StatusCode, resp.Duration)
+	}
+	if resp.Error == nil || resp.Error.Message != "Not found" {
+		t.Errorf("Expected the decoded API error, got %v", resp.Error)
+	}
+}
+
+func TestOnAfterResponse_noResponse(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, typesHandler())
+	teardown()
+
+	var calls int32
+	client.OnAfterResponse(func(ctx context.Context, resp *ResponseInfo) {
+		atomic.AddInt32(&calls, 1)
+		if resp.StatusCode != 0 || resp.Error == nil || resp.Resource != "types" {
+			t.Errorf("Expected a types request without a response to have an error, got %d %v", resp.StatusCode, resp.Error)
+		}
+	})
+
+	if _, err := client.ListTypes(context.Background(), nil); err == nil {
+		t.Fatal("Expected an error from a closed server")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 response, got %d", calls)
+	}
+}
+
+func TestOnAfterResponse_invalidJSON(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 456, "label":`))
+	}))
+	defer teardown()
+
+	var responses []*ResponseInfo
+	client.OnAfterResponse(func(ctx context.Context, resp *ResponseInfo) {
+		responses = append(responses, resp)
+	})
+	metrics := NewInMemoryMetrics()
+	client.SetMetricsCollector(metrics)
+	recorder := &SpanRecorder{}
+	client.SetTracer(recorder)
+
+	if _, err := client.GetInstanceDisk(context.Background(), 123, 456); err == nil {
+		t.Fatal("Expected an error decoding the response")
+	}
+	if len(responses) != 1 {
+		t.Fatalf("Expected 1 response, got %d", len(responses))
+	}
+	if resp := responses[0]; resp.StatusCode != http.StatusOK || resp.Error == nil {
+		t.Errorf("Expected a 200 status with an error, got %d and %v", resp.StatusCode, resp.Error)
+	}
+
+	disks := metrics.Snapshot()[MetricLabels{Resource: "disks", Method: http.MethodGet}]
+	if disks.Requests != 1 {
+		t.Errorf("Expected 1 disks request to be completed, got %+v", disks)
+	}
+	if spans := recorder.Spans(); len(spans) != 1 || spans[0].Err == nil {
+		t.Errorf("Expected 1 span ending with an error, got %+v", spans)
+	}
+}
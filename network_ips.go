@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -24,30 +23,28 @@ func (i InstanceIP) GetUpdateOptions() (o IPAddressUpdateOptions) {
 	return
 }
 
-// endpoint gets the endpoint URL for IPAddress
-func (IPAddressesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.IPAddresses.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends IPAddresses when processing paginated InstanceIPAddress responses
-func (resp *IPAddressesPagedResponse) appendData(r *IPAddressesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *IPAddressesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*IPAddressesPagedResponse).Data...)
 }
 
 // ListIPAddresses lists IPAddresses
 func (c *Client) ListIPAddresses(ctx context.Context, opts *ListOptions) ([]InstanceIP, error) {
 	response := IPAddressesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListIPAddresses", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListIPAddressesFunc calls fn with each InstanceIP, fetching pages as they are needed
+func (c *Client) ListIPAddressesFunc(ctx context.Context, opts *ListOptions, fn func(InstanceIP) error) error {
+	return c.listFunc(ctx, "ListIPAddressesFunc", &IPAddressesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*InstanceIP))
+	})
+}
+
 // GetIPAddress gets the template with the provided ID
 func (c *Client) GetIPAddress(ctx context.Context, id string) (*InstanceIP, error) {
 	e, err := c.IPAddresses.Endpoint()
@@ -55,7 +52,7 @@ func (c *Client) GetIPAddress(ctx context.Context, id string) (*InstanceIP, erro
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&InstanceIP{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetIPAddress").SetResult(&InstanceIP{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -71,9 +68,9 @@ func (c *Client) UpdateIPAddress(ctx context.Context, id string, updateOpts IPAd
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
 
-	req := c.R(ctx).SetResult(&InstanceIP{})
+	req := c.request(ctx, "UpdateIPAddress").SetResult(&InstanceIP{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -11,30 +11,28 @@ type IPv6PoolsPagedResponse struct {
 	Data []IPv6Range `json:"data"`
 }
 
-// endpoint gets the endpoint URL for IPv6Pool
-func (IPv6PoolsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.IPv6Pools.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends IPv6Pools when processing paginated IPv6Pool responses
-func (resp *IPv6PoolsPagedResponse) appendData(r *IPv6PoolsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *IPv6PoolsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*IPv6PoolsPagedResponse).Data...)
 }
 
 // ListIPv6Pools lists IPv6Pools
 func (c *Client) ListIPv6Pools(ctx context.Context, opts *ListOptions) ([]IPv6Range, error) {
 	response := IPv6PoolsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListIPv6Pools", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListIPv6PoolsFunc calls fn with each IPv6Range, fetching pages as they are needed
+func (c *Client) ListIPv6PoolsFunc(ctx context.Context, opts *ListOptions, fn func(IPv6Range) error) error {
+	return c.listFunc(ctx, "ListIPv6PoolsFunc", &IPv6PoolsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*IPv6Range))
+	})
+}
+
 // GetIPv6Pool gets the template with the provided ID
 func (c *Client) GetIPv6Pool(ctx context.Context, id string) (*IPv6Range, error) {
 	e, err := c.IPv6Pools.Endpoint()
@@ -42,7 +40,7 @@ func (c *Client) GetIPv6Pool(ctx context.Context, id string) (*IPv6Range, error)
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&IPv6Range{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetIPv6Pool").SetResult(&IPv6Range{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
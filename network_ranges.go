@@ -11,30 +11,28 @@ type IPv6RangesPagedResponse struct {
 	Data []IPv6Range `json:"data"`
 }
 
-// endpoint gets the endpoint URL for IPv6Range
-func (IPv6RangesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.IPv6Ranges.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends IPv6Ranges when processing paginated IPv6Range responses
-func (resp *IPv6RangesPagedResponse) appendData(r *IPv6RangesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *IPv6RangesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*IPv6RangesPagedResponse).Data...)
 }
 
 // ListIPv6Ranges lists IPv6Ranges
 func (c *Client) ListIPv6Ranges(ctx context.Context, opts *ListOptions) ([]IPv6Range, error) {
 	response := IPv6RangesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListIPv6Ranges", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListIPv6RangesFunc calls fn with each IPv6Range, fetching pages as they are needed
+func (c *Client) ListIPv6RangesFunc(ctx context.Context, opts *ListOptions, fn func(IPv6Range) error) error {
+	return c.listFunc(ctx, "ListIPv6RangesFunc", &IPv6RangesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*IPv6Range))
+	})
+}
+
 // GetIPv6Range gets the template with the provided ID
 func (c *Client) GetIPv6Range(ctx context.Context, id string) (*IPv6Range, error) {
 	e, err := c.IPv6Ranges.Endpoint()
@@ -42,7 +40,7 @@ func (c *Client) GetIPv6Range(ctx context.Context, id string) (*IPv6Range, error
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&IPv6Range{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetIPv6Range").SetResult(&IPv6Range{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
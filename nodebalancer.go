@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -86,28 +85,27 @@ type NodeBalancersPagedResponse struct {
 	Data []NodeBalancer `json:"data"`
 }
 
-func (NodeBalancersPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.NodeBalancers.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
-func (resp *NodeBalancersPagedResponse) appendData(r *NodeBalancersPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *NodeBalancersPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*NodeBalancersPagedResponse).Data...)
 }
 
 // ListNodeBalancers lists NodeBalancers
 func (c *Client) ListNodeBalancers(ctx context.Context, opts *ListOptions) ([]NodeBalancer, error) {
 	response := NodeBalancersPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListNodeBalancers", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListNodeBalancersFunc calls fn with each NodeBalancer, fetching pages as they are needed
+func (c *Client) ListNodeBalancersFunc(ctx context.Context, opts *ListOptions, fn func(NodeBalancer) error) error {
+	return c.listFunc(ctx, "ListNodeBalancersFunc", &NodeBalancersPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*NodeBalancer))
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *NodeBalancer) fixDates() *NodeBalancer {
 	i.Created, _ = parseDates(i.CreatedStr)
@@ -122,7 +120,7 @@ func (c *Client) GetNodeBalancer(ctx context.Context, id int) (*NodeBalancer, er
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetNodeBalancer").
 		SetResult(&NodeBalancer{}).
 		Get(e))
 	if err != nil {
@@ -139,9 +137,9 @@ func (c *Client) CreateNodeBalancer(ctx context.Context, nodebalancer NodeBalanc
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&NodeBalancer{})
+	req := c.request(ctx, "CreateNodeBalancer").SetResult(&NodeBalancer{})
 
-	if bodyData, err := json.Marshal(nodebalancer); err == nil {
+	if bodyData, err := c.marshalBody(ctx, nodebalancer); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -167,9 +165,9 @@ func (c *Client) UpdateNodeBalancer(ctx context.Context, id int, updateOpts Node
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&NodeBalancer{})
+	req := c.request(ctx, "UpdateNodeBalancer").SetResult(&NodeBalancer{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -193,7 +191,7 @@ func (c *Client) DeleteNodeBalancer(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteNodeBalancer").Delete(e))
 
 	return err
 }
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -74,24 +73,15 @@ type NodeBalancerNodesPagedResponse struct {
 	Data []NodeBalancerNode `json:"data"`
 }
 
-// endpoint gets the endpoint URL for NodeBalancerNode
-func (NodeBalancerNodesPagedResponse) endpointWithTwoIDs(c *Client, nodebalancerID int, configID int) string {
-	endpoint, err := c.NodeBalancerNodes.endpointWithID(nodebalancerID, configID)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends NodeBalancerNodes when processing paginated NodeBalancerNode responses
-func (resp *NodeBalancerNodesPagedResponse) appendData(r *NodeBalancerNodesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *NodeBalancerNodesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*NodeBalancerNodesPagedResponse).Data...)
 }
 
 // ListNodeBalancerNodes lists NodeBalancerNodes
 func (c *Client) ListNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *ListOptions) ([]NodeBalancerNode, error) {
 	response := NodeBalancerNodesPagedResponse{}
-	err := c.listHelperWithTwoIDs(ctx, &response, nodebalancerID, configID, opts)
+	err := c.listHelperWithTwoIDs(ctx, "ListNodeBalancerNodes", &response, nodebalancerID, configID, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -101,6 +91,13 @@ func (c *Client) ListNodeBalancerNodes(ctx context.Context, nodebalancerID int,
 	return response.Data, nil
 }
 
+// ListNodeBalancerNodesFunc calls fn with each NodeBalancerNode, fetching pages as they are needed
+func (c *Client) ListNodeBalancerNodesFunc(ctx context.Context, nodebalancerID int, configID int, opts *ListOptions, fn func(NodeBalancerNode) error) error {
+	return c.listFunc(ctx, "ListNodeBalancerNodesFunc", &NodeBalancerNodesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*NodeBalancerNode).fixDates())
+	}, nodebalancerID, configID)
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *NodeBalancerNode) fixDates() *NodeBalancerNode {
 	return i
@@ -113,7 +110,7 @@ func (c *Client) GetNodeBalancerNode(ctx context.Context, nodebalancerID int, co
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, nodeID)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&NodeBalancerNode{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetNodeBalancerNode").SetResult(&NodeBalancerNode{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -128,9 +125,9 @@ func (c *Client) CreateNodeBalancerNode(ctx context.Context, nodebalancerID int,
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&NodeBalancerNode{})
+	req := c.request(ctx, "CreateNodeBalancerNode").SetResult(&NodeBalancerNode{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -155,9 +152,9 @@ func (c *Client) UpdateNodeBalancerNode(ctx context.Context, nodebalancerID int,
 	}
 	e = fmt.Sprintf("%s/%d", e, nodeID)
 
-	req := c.R(ctx).SetResult(&NodeBalancerNode{})
+	req := c.request(ctx, "UpdateNodeBalancerNode").SetResult(&NodeBalancerNode{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -181,6 +178,6 @@ func (c *Client) DeleteNodeBalancerNode(ctx context.Context, nodebalancerID int,
 	}
 	e = fmt.Sprintf("%s/%d", e, nodeID)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteNodeBalancerNode").Delete(e))
 	return err
 }
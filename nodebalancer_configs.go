@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -25,7 +24,7 @@ type NodeBalancerConfig struct {
 	SSLCommonName  string                  `json:"ssl_commonname"`
 	SSLFingerprint string                  `json:"ssl_fingerprint"`
 	SSLCert        string                  `json:"ssl_cert"`
-	SSLKey         string                  `json:"ssl_key"`
+	SSLKey         string                  `json:"ssl_key" redact:"true"`
 	NodesStatus    *NodeBalancerNodeStatus `json:"nodes_status"`
 }
 
@@ -100,7 +99,7 @@ type NodeBalancerConfigCreateOptions struct {
 	CheckTimeout  int                             `json:"check_timeout,omitempty"`
 	CipherSuite   ConfigCipher                    `json:"cipher_suite,omitempty"`
 	SSLCert       string                          `json:"ssl_cert,omitempty"`
-	SSLKey        string                          `json:"ssl_key,omitempty"`
+	SSLKey        string                          `json:"ssl_key,omitempty" redact:"true"`
 	Nodes         []NodeBalancerNodeCreateOptions `json:"nodes,omitempty"`
 }
 
@@ -119,7 +118,7 @@ type NodeBalancerConfigRebuildOptions struct {
 	CheckTimeout  int                             `json:"check_timeout,omitempty"`
 	CipherSuite   ConfigCipher                    `json:"cipher_suite,omitempty"`
 	SSLCert       string                          `json:"ssl_cert,omitempty"`
-	SSLKey        string                          `json:"ssl_key,omitempty"`
+	SSLKey        string                          `json:"ssl_key,omitempty" redact:"true"`
 	Nodes         []NodeBalancerNodeCreateOptions `json:"nodes"`
 }
 
@@ -193,24 +192,15 @@ type NodeBalancerConfigsPagedResponse struct {
 	Data []NodeBalancerConfig `json:"data"`
 }
 
-// endpointWithID gets the endpoint URL for NodeBalancerConfig
-func (NodeBalancerConfigsPagedResponse) endpointWithID(c *Client, id int) string {
-	endpoint, err := c.NodeBalancerConfigs.endpointWithID(id)
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends NodeBalancerConfigs when processing paginated NodeBalancerConfig responses
-func (resp *NodeBalancerConfigsPagedResponse) appendData(r *NodeBalancerConfigsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *NodeBalancerConfigsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*NodeBalancerConfigsPagedResponse).Data...)
 }
 
 // ListNodeBalancerConfigs lists NodeBalancerConfigs
 func (c *Client) ListNodeBalancerConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) ([]NodeBalancerConfig, error) {
 	response := NodeBalancerConfigsPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, nodebalancerID, opts)
+	err := c.listHelperWithID(ctx, "ListNodeBalancerConfigs", &response, nodebalancerID, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -220,6 +210,13 @@ func (c *Client) ListNodeBalancerConfigs(ctx context.Context, nodebalancerID int
 	return response.Data, nil
 }
 
+// ListNodeBalancerConfigsFunc calls fn with each NodeBalancerConfig, fetching pages as they are needed
+func (c *Client) ListNodeBalancerConfigsFunc(ctx context.Context, nodebalancerID int, opts *ListOptions, fn func(NodeBalancerConfig) error) error {
+	return c.listFunc(ctx, "ListNodeBalancerConfigsFunc", &NodeBalancerConfigsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*NodeBalancerConfig).fixDates())
+	}, nodebalancerID)
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *NodeBalancerConfig) fixDates() *NodeBalancerConfig {
 	return i
@@ -232,7 +229,7 @@ func (c *Client) GetNodeBalancerConfig(ctx context.Context, nodebalancerID int,
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&NodeBalancerConfig{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetNodeBalancerConfig").SetResult(&NodeBalancerConfig{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -248,9 +245,9 @@ func (c *Client) CreateNodeBalancerConfig(ctx context.Context, nodebalancerID in
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&NodeBalancerConfig{})
+	req := c.request(ctx, "CreateNodeBalancerConfig").SetResult(&NodeBalancerConfig{})
 
-	if bodyData, err := json.Marshal(nodebalancerConfig); err == nil {
+	if bodyData, err := c.marshalBody(ctx, nodebalancerConfig); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -276,9 +273,9 @@ func (c *Client) UpdateNodeBalancerConfig(ctx context.Context, nodebalancerID in
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
 
-	req := c.R(ctx).SetResult(&NodeBalancerConfig{})
+	req := c.request(ctx, "UpdateNodeBalancerConfig").SetResult(&NodeBalancerConfig{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -302,7 +299,7 @@ func (c *Client) DeleteNodeBalancerConfig(ctx context.Context, nodebalancerID in
 	}
 	e = fmt.Sprintf("%s/%d", e, configID)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteNodeBalancerConfig").Delete(e))
 	return err
 }
 
@@ -315,9 +312,9 @@ func (c *Client) RebuildNodeBalancerConfig(ctx context.Context, nodeBalancerID i
 	}
 	e = fmt.Sprintf("%s/%d/rebuild", e, configID)
 
-	req := c.R(ctx).SetResult(&NodeBalancerConfig{})
+	req := c.request(ctx, "RebuildNodeBalancerConfig").SetResult(&NodeBalancerConfig{})
 
-	if bodyData, err := json.Marshal(rebuildOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, rebuildOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -0,0 +1,255 @@
+/*
+Package oauth helps third-party applications log Linode users in with OAuth 2.
+
+A Config is usually made from the OAuthClient registered for the application:
+
+	conf := oauth.NewConfig(oauthClient, linodego.Scopes{
+		"linodes": linodego.ScopeReadWrite,
+		"domains": linodego.ScopeReadOnly,
+	})
+
+	// redirect the user to the login service
+	http.Redirect(w, r, conf.AuthCodeURL(state), http.StatusFound)
+
+	// then, on the OAuthClient's redirect URI
+	token, err := conf.Exchange(ctx, r.FormValue("code"))
+	client := linodego.NewClient(conf.HTTPClient(ctx, token))
+
+Tokens are refreshed as they expire, and saved to the Config's TokenStore, if any.
+*/
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/linode/linodego"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// AuthURL is the URL of the login service's authorization page
+	AuthURL = "https://login.linode.com/oauth/authorize"
+	// TokenURL is the URL of the login service's token exchange
+	TokenURL = "https://login.linode.com/oauth/token"
+)
+
+// Endpoint is the login.linode.com OAuth 2 endpoint. The login service
+// expects the client credentials in the request body, rather than in an
+// Authorization header.
+var Endpoint = oauth2.Endpoint{
+	AuthURL:   AuthURL,
+	TokenURL:  TokenURL,
+	AuthStyle: oauth2.AuthStyleInParams,
+}
+
+// TokenStore persists the tokens of a Config, such as between runs of an application
+type TokenStore interface {
+	// Load returns the saved token, or nil if there is none
+	Load() (*oauth2.Token, error)
+	// Save saves the token, replacing any saved before
+	Save(token *oauth2.Token) error
+}
+
+// Config describes an application's use of the login service
+type Config struct {
+	// ClientID is the ID of the application's OAuthClient
+	ClientID string
+	// ClientSecret is the secret of the application's OAuthClient
+	ClientSecret string
+	// RedirectURL is the OAuthClient's redirect URI, receiving the exchange code
+	RedirectURL string
+	// Scopes are the scopes requested of the user, such as
+	// linodego.Scopes{linodego.ScopeAll: linodego.ScopeReadWrite}
+	Scopes linodego.Scopes
+
+	// Endpoint is the login service's endpoint. The zero value uses Endpoint.
+	Endpoint oauth2.Endpoint
+
+	// Store, if set, saves the tokens exchanged or refreshed
+	Store TokenStore
+}
+
+// NewConfig returns a Config for the OAuthClient, requesting the scopes. The
+// OAuthClient's Secret is only returned when it is created or reset.
+func NewConfig(client linodego.OAuthClient, scopes linodego.Scopes) *Config {
+	return &Config{
+		ClientID:     client.ID,
+		ClientSecret: client.Secret,
+		RedirectURL:  client.RedirectURI,
+		Scopes:       scopes,
+	}
+}
+
+// oauth2Config returns the golang.org/x/oauth2 Config used by the Config
+func (c *Config) oauth2Config() *oauth2.Config {
+	endpoint := c.Endpoint
+	if len(endpoint.TokenURL) == 0 {
+		endpoint = Endpoint
+	}
+
+	if endpoint.AuthStyle == oauth2.AuthStyleAutoDetect {
+		endpoint.AuthStyle = Endpoint.AuthStyle
+	}
+
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     endpoint,
+		RedirectURL:  c.RedirectURL,
+		Scopes:       strings.Fields(c.Scopes.String()),
+	}
+}
+
+// AuthCodeURL returns the URL of the login page, asking the user to grant
+// the application its Scopes. The state is returned with the exchange code,
+// and should be verified to match.
+func (c *Config) AuthCodeURL(state string) string {
+	return c.oauth2Config().AuthCodeURL(state)
+}
+
+// Exchange exchanges the code received by the RedirectURL for a token, which
+// is saved to the Store, if any
+func (c *Config) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	token, err := c.oauth2Config().Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	return token, c.save(token)
+}
+
+// Refresh returns a new token for the refresh token of the given token, which
+// is saved to the Store, if any
+func (c *Config) Refresh(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error) {
+	if token == nil || len(token.RefreshToken) == 0 {
+		return nil, errors.New("oauth: the token has no refresh token")
+	}
+
+	// A token source without an access token refreshes immediately
+	refreshed, err := c.oauth2Config().TokenSource(ctx, &oauth2.Token{RefreshToken: token.RefreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+	return refreshed, c.save(refreshed)
+}
+
+// TokenSource returns an oauth2.TokenSource returning the token until it
+// expires, then refreshing it. Refreshed tokens are saved to the Store, if any.
+func (c *Config) TokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+	return &storingTokenSource{
+		config: c,
+		source: c.oauth2Config().TokenSource(ctx, token),
+		last:   token,
+	}
+}
+
+// HTTPClient returns an http.Client authorized by the token, refreshing it as
+// needed, for use with linodego.NewClient
+func (c *Config) HTTPClient(ctx context.Context, token *oauth2.Token) *http.Client {
+	return oauth2.NewClient(ctx, c.TokenSource(ctx, token))
+}
+
+// StoredHTTPClient returns an http.Client authorized by the token loaded from
+// the Store, for use with linodego.NewClient
+func (c *Config) StoredHTTPClient(ctx context.Context) (*http.Client, error) {
+	if c.Store == nil {
+		return nil, errors.New("oauth: the Config has no Store")
+	}
+	token, err := c.Store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, errors.New("oauth: the Store has no token")
+	}
+	return c.HTTPClient(ctx, token), nil
+}
+
+func (c *Config) save(token *oauth2.Token) error {
+	if c.Store == nil {
+		return nil
+	}
+	return c.Store.Save(token)
+}
+
+// storingTokenSource saves the tokens of its source as they change
+type storingTokenSource struct {
+	config *Config
+	source oauth2.TokenSource
+
+	mu   sync.Mutex
+	last *oauth2.Token
+}
+
+func (s *storingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.last == nil || s.last.AccessToken != token.AccessToken {
+		if err := s.config.save(token); err != nil {
+			return nil, err
+		}
+		s.last = token
+	}
+	return token, nil
+}
+
+// FileTokenStore is a TokenStore saving the token as JSON in the named file
+type FileTokenStore string
+
+var _ TokenStore = FileTokenStore("")
+
+// Load implements TokenStore. A missing file holds no token.
+func (f FileTokenStore) Load() (*oauth2.Token, error) {
+	data, err := ioutil.ReadFile(string(f))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Save implements TokenStore. The file is only readable by its owner.
+func (f FileTokenStore) Save(token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	// Write a temporary file in the same directory first, so that the saved
+	// token is never partially written
+	tmp, err := ioutil.TempFile(filepath.Dir(string(f)), filepath.Base(string(f))+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(0600)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), string(f))
+}
@@ -0,0 +1,239 @@
+package oauth_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/linodego/oauth"
+	"golang.org/x/oauth2"
+)
+
+// loginService is a stand-in for login.linode.com, issuing numbered tokens
+type loginService struct {
+	t      *testing.T
+	issued int32
+}
+
+func (l *loginService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/oauth/token" || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		l.t.Fatal(err)
+	}
+	if r.PostForm.Get("client_id") != "client-id" || r.PostForm.Get("client_secret") != "client-secret" {
+		l.t.Errorf("Expected the client credentials in the form, got %v", r.PostForm)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		if r.PostForm.Get("code") != "the-code" || r.PostForm.Get("redirect_uri") != "https://app.example.com/callback" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != "refresh-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	n := atomic.AddInt32(&l.issued, 1)
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"access_token": "access-%d", "token_type": "bearer", "refresh_token": "refresh-token", "expires_in": 7200, "scopes": "linodes:read_write"}`, n)
+}
+
+// memoryStore is a TokenStore recording the tokens saved
+type memoryStore struct {
+	saved []*oauth2.Token
+}
+
+func (m *memoryStore) Load() (*oauth2.Token, error) {
+	if len(m.saved) == 0 {
+		return nil, nil
+	}
+	return m.saved[len(m.saved)-1], nil
+}
+
+func (m *memoryStore) Save(token *oauth2.Token) error {
+	m.saved = append(m.saved, token)
+	return nil
+}
+
+func newTestConfig(t *testing.T) (*oauth.Config, *memoryStore, func()) {
+	server := httptest.NewServer(&loginService{t: t})
+	store := &memoryStore{}
+
+	conf := oauth.NewConfig(linodego.OAuthClient{
+		ID:          "client-id",
+		Secret:      "client-secret",
+		RedirectURI: "https://app.example.com/callback",
+	}, linodego.Scopes{"linodes": linodego.ScopeReadWrite, "domains": linodego.ScopeReadOnly})
+	conf.Endpoint = oauth2.Endpoint{
+		AuthURL:  server.URL + "/oauth/authorize",
+		TokenURL: server.URL + "/oauth/token",
+	}
+	conf.Store = store
+
+	return conf, store, server.Close
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	conf := oauth.NewConfig(linodego.OAuthClient{ID: "client-id", RedirectURI: "https://app.example.com/callback"},
+		linodego.Scopes{linodego.ScopeAll: linodego.ScopeReadWrite})
+
+	u, err := url.Parse(conf.AuthCodeURL("some-state"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme+"://"+u.Host+u.Path != oauth.AuthURL {
+		t.Errorf("Expected the login service's authorization page, got %s", u)
+	}
+
+	q := u.Query()
+	for key, value := range map[string]string{
+		"client_id":     "client-id",
+		"response_type": "code",
+		"redirect_uri":  "https://app.example.com/callback",
+		"scope":         "*",
+		"state":         "some-state",
+	} {
+		if q.Get(key) != value {
+			t.Errorf("Expected %s to be %q, got %q", key, value, q.Get(key))
+		}
+	}
+
+	conf.Scopes = linodego.Scopes{"linodes": linodego.ScopeReadWrite, "domains": linodego.ScopeReadOnly}
+	u, _ = url.Parse(conf.AuthCodeURL("some-state"))
+	if scope := u.Query().Get("scope"); scope != "domains:read_only linodes:read_write" {
+		t.Errorf("Expected space separated scopes, got %q", scope)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	conf, store, teardown := newTestConfig(t)
+	defer teardown()
+
+	token, err := conf.Exchange(context.Background(), "the-code")
+	if err != nil {
+		t.Fatalf("Error exchanging code, got %v", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-token" || token.Expiry.IsZero() {
+		t.Errorf("Unexpected token %+v", token)
+	}
+	if len(store.saved) != 1 || store.saved[0] != token {
+		t.Errorf("Expected the token to be saved, got %v", store.saved)
+	}
+
+	if _, err := conf.Exchange(context.Background(), "wrong-code"); err == nil {
+		t.Error("Expected an error exchanging an invalid code")
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	conf, store, teardown := newTestConfig(t)
+	defer teardown()
+
+	token, err := conf.Refresh(context.Background(), &oauth2.Token{AccessToken: "old", RefreshToken: "refresh-token"})
+	if err != nil {
+		t.Fatalf("Error refreshing token, got %v", err)
+	}
+	if token.AccessToken != "access-1" {
+		t.Errorf("Expected a new access token, got %+v", token)
+	}
+	if len(store.saved) != 1 {
+		t.Errorf("Expected the refreshed token to be saved, got %v", store.saved)
+	}
+
+	if _, err := conf.Refresh(context.Background(), &oauth2.Token{AccessToken: "old"}); err == nil {
+		t.Error("Expected an error refreshing a token without a refresh token")
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	conf, store, teardown := newTestConfig(t)
+	defer teardown()
+
+	var authorizations []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"username": "someone"}`))
+	}))
+	defer api.Close()
+
+	// an expired token is refreshed before the first request
+	expired := &oauth2.Token{AccessToken: "expired", RefreshToken: "refresh-token", Expiry: time.Now().Add(-time.Hour)}
+	client := linodego.NewClient(conf.HTTPClient(context.Background(), expired))
+	client.SetBaseURL(api.URL)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetProfile(context.Background()); err != nil {
+			t.Fatalf("Error getting profile, got %v", err)
+		}
+	}
+
+	if len(authorizations) != 2 || authorizations[0] != "Bearer access-1" || authorizations[1] != "Bearer access-1" {
+		t.Errorf("Expected requests authorized by the refreshed token, got %v", authorizations)
+	}
+	if len(store.saved) != 1 || store.saved[0].AccessToken != "access-1" {
+		t.Errorf("Expected the refreshed token to be saved once, got %v", store.saved)
+	}
+
+	stored, err := conf.StoredHTTPClient(context.Background())
+	if err != nil || stored == nil {
+		t.Errorf("Expected a client for the stored token, got %v", err)
+	}
+}
+
+func TestFileTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "linodego")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store := oauth.FileTokenStore(filepath.Join(dir, "token.json"))
+
+	if token, err := store.Load(); token != nil || err != nil {
+		t.Errorf("Expected no token before saving, got %v and %v", token, err)
+	}
+
+	expiry := time.Now().Add(time.Hour).Round(time.Second)
+	if err := store.Save(&oauth2.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: expiry}); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access" || token.RefreshToken != "refresh" || !token.Expiry.Equal(expiry) {
+		t.Errorf("Expected the saved token, got %+v", token)
+	}
+	info, err := os.Stat(string(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected the token file to be only readable by its owner, got %v", info.Mode())
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("Expected no temporary file to be left, got %d files", len(files))
+	}
+}
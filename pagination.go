@@ -6,9 +6,11 @@ package linodego
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"reflect"
 	"strconv"
+	"sync"
 
 	"gopkg.in/resty.v1"
 )
@@ -18,8 +20,19 @@ type PageOptions struct {
 	Page    int `url:"page,omitempty" json:"page"`
 	Pages   int `url:"pages,omitempty" json:"pages"`
 	Results int `url:"results,omitempty" json:"results"`
+
+	// PageSize is the number of results requested per page, between MinPageSize
+	// and MaxPageSize. The API's default page size is used when it is 0.
+	PageSize int `url:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
+const (
+	// MinPageSize is the smallest page_size accepted by the API
+	MinPageSize = 25
+	// MaxPageSize is the largest page_size accepted by the API
+	MaxPageSize = 500
+)
+
 // ListOptions are the pagination and filtering (TODO) parameters for endpoints
 type ListOptions struct {
 	*PageOptions
@@ -33,405 +46,299 @@ func NewListOptions(page int, filter string) *ListOptions {
 
 }
 
-// listHelper abstracts fetching and pagination for GET endpoints that
-// do not require any Ids (top level endpoints).
-// When opts (or opts.Page) is nil, all pages will be fetched and
-// returned in a single (endpoint-specific)PagedResponse
-// opts.results and opts.pages will be updated from the API response
-func (c *Client) listHelper(ctx context.Context, i interface{}, opts *ListOptions) error {
-	req := c.R(ctx)
-	if opts != nil && opts.PageOptions != nil && opts.Page > 0 {
-		req.SetQueryParam("page", strconv.Itoa(opts.Page))
+// applyListOptions sets the pagination and filtering parameters of opts on the
+// request, returning an error when they are not accepted by the API
+func applyListOptions(req *resty.Request, opts *ListOptions) error {
+	if opts == nil {
+		return nil
 	}
 
-	var (
-		err     error
-		pages   int
-		results int
-		r       *resty.Response
-	)
+	if opts.PageOptions != nil {
+		if opts.Page > 0 {
+			req.SetQueryParam("page", strconv.Itoa(opts.Page))
+		}
+		if opts.PageSize != 0 {
+			if opts.PageSize < MinPageSize || opts.PageSize > MaxPageSize {
+				return NewError(fmt.Sprintf("PageSize %d must be between %d and %d", opts.PageSize, MinPageSize, MaxPageSize))
+			}
+			req.SetQueryParam("page_size", strconv.Itoa(opts.PageSize))
+		}
+	}
 
-	if opts != nil && len(opts.Filter) > 0 {
+	if len(opts.Filter) > 0 {
 		req.SetHeader("X-Filter", opts.Filter)
 	}
+	return nil
+}
 
-	switch v := i.(type) {
-	case *LinodeKernelsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(LinodeKernelsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*LinodeKernelsPagedResponse).Pages
-			results = r.Result().(*LinodeKernelsPagedResponse).Results
-			v.appendData(r.Result().(*LinodeKernelsPagedResponse))
-		}
-	case *LinodeTypesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(LinodeTypesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*LinodeTypesPagedResponse).Pages
-			results = r.Result().(*LinodeTypesPagedResponse).Results
-			v.appendData(r.Result().(*LinodeTypesPagedResponse))
-		}
-	case *ImagesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(ImagesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*ImagesPagedResponse).Pages
-			results = r.Result().(*ImagesPagedResponse).Results
-			v.appendData(r.Result().(*ImagesPagedResponse))
-		}
-	case *StackscriptsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(StackscriptsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*StackscriptsPagedResponse).Pages
-			results = r.Result().(*StackscriptsPagedResponse).Results
-			v.appendData(r.Result().(*StackscriptsPagedResponse))
-		}
-	case *InstancesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(InstancesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*InstancesPagedResponse).Pages
-			results = r.Result().(*InstancesPagedResponse).Results
-			v.appendData(r.Result().(*InstancesPagedResponse))
-		}
-	case *RegionsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(RegionsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*RegionsPagedResponse).Pages
-			results = r.Result().(*RegionsPagedResponse).Results
-			v.appendData(r.Result().(*RegionsPagedResponse))
-		}
-	case *VolumesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(VolumesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*VolumesPagedResponse).Pages
-			results = r.Result().(*VolumesPagedResponse).Results
-			v.appendData(r.Result().(*VolumesPagedResponse))
-		}
-	case *DomainsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(DomainsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			response, ok := r.Result().(*DomainsPagedResponse)
-			if !ok {
-				return fmt.Errorf("response is not a *DomainsPagedResponse")
+// ErrStopIteration may be returned by the function given to a List*Func method,
+// such as ListInstancesFunc, to stop fetching pages without returning an error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// forEachPage calls fetch for each page of a List endpoint, fetching pages only
+// as they are needed. Pages are fetched from opts.Page (or the first page) until
+// the last page. opts is updated with the page being fetched, and with the
+// number of pages and results reported by the API, so it can be inspected by
+// the caller as the pages are visited.
+func forEachPage(opts *ListOptions, fetch func(opts *ListOptions) error) error {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.PageOptions == nil {
+		opts.PageOptions = &PageOptions{}
+	}
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+
+	for ; ; opts.Page++ {
+		if err := fetch(opts); err != nil {
+			if err == ErrStopIteration {
+				return nil
 			}
-			pages = response.Pages
-			results = response.Results
-			v.appendData(response)
-		}
-	case *EventsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(EventsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*EventsPagedResponse).Pages
-			results = r.Result().(*EventsPagedResponse).Results
-			v.appendData(r.Result().(*EventsPagedResponse))
-		}
-	case *LongviewSubscriptionsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(LongviewSubscriptionsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*LongviewSubscriptionsPagedResponse).Pages
-			results = r.Result().(*LongviewSubscriptionsPagedResponse).Results
-			v.appendData(r.Result().(*LongviewSubscriptionsPagedResponse))
-		}
-	case *LongviewClientsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(LongviewClientsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*LongviewClientsPagedResponse).Pages
-			results = r.Result().(*LongviewClientsPagedResponse).Results
-			v.appendData(r.Result().(*LongviewClientsPagedResponse))
+			return err
 		}
-	case *IPAddressesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(IPAddressesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*IPAddressesPagedResponse).Pages
-			results = r.Result().(*IPAddressesPagedResponse).Results
-			v.appendData(r.Result().(*IPAddressesPagedResponse))
+
+		if opts.Page >= opts.Pages {
+			return nil
 		}
-	case *IPv6PoolsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(IPv6PoolsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*IPv6PoolsPagedResponse).Pages
-			results = r.Result().(*IPv6PoolsPagedResponse).Results
-			v.appendData(r.Result().(*IPv6PoolsPagedResponse))
+	}
+}
+
+// listFunc calls each with a pointer to every item in the Data of the pages of
+// the (endpoint-specific)PagedResponse i, fetching pages as they are needed (see
+// forEachPage). The endpoint is rendered with the params (IDs). It backs the
+// List*Func methods, whose each passes the item on to their typed fn.
+func (c *Client) listFunc(ctx context.Context, operation string, i pagedResponse, opts *ListOptions, each func(item interface{}) error, params ...interface{}) error {
+	return forEachPage(opts, func(opts *ListOptions) error {
+		page := newPage(i)
+		if err := c.listPaged(ctx, operation, page, opts, params...); err != nil {
+			return err
 		}
-	case *IPv6RangesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(IPv6RangesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*IPv6RangesPagedResponse).Pages
-			results = r.Result().(*IPv6RangesPagedResponse).Results
-			v.appendData(r.Result().(*IPv6RangesPagedResponse))
-			// @TODO consolidate this type with IPv6PoolsPagedResponse?
+
+		data := reflect.ValueOf(page).Elem().FieldByName("Data")
+		if data.Kind() != reflect.Slice {
+			return NewError(fmt.Sprintf("%T has no Data to list", page))
 		}
-	case *SSHKeysPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(SSHKeysPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			response, ok := r.Result().(*SSHKeysPagedResponse)
-			if !ok {
-				return fmt.Errorf("response is not a *SSHKeysPagedResponse")
+		for n := 0; n < data.Len(); n++ {
+			if err := each(data.Index(n).Addr().Interface()); err != nil {
+				return err
 			}
-			pages = response.Pages
-			results = response.Results
-			v.appendData(response)
-		}
-	case *TicketsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(TicketsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*TicketsPagedResponse).Pages
-			results = r.Result().(*TicketsPagedResponse).Results
-			v.appendData(r.Result().(*TicketsPagedResponse))
-		}
-	case *InvoicesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(InvoicesPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*InvoicesPagedResponse).Pages
-			results = r.Result().(*InvoicesPagedResponse).Results
-			v.appendData(r.Result().(*InvoicesPagedResponse))
-		}
-	case *NotificationsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(NotificationsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*NotificationsPagedResponse).Pages
-			results = r.Result().(*NotificationsPagedResponse).Results
-			v.appendData(r.Result().(*NotificationsPagedResponse))
 		}
-	case *OAuthClientsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(OAuthClientsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*OAuthClientsPagedResponse).Pages
-			results = r.Result().(*OAuthClientsPagedResponse).Results
-			v.appendData(r.Result().(*OAuthClientsPagedResponse))
-		}
-	case *PaymentsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(PaymentsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*PaymentsPagedResponse).Pages
-			results = r.Result().(*PaymentsPagedResponse).Results
-			v.appendData(r.Result().(*PaymentsPagedResponse))
-		}
-	case *NodeBalancersPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(NodeBalancersPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*NodeBalancersPagedResponse).Pages
-			results = r.Result().(*NodeBalancersPagedResponse).Results
-			v.appendData(r.Result().(*NodeBalancersPagedResponse))
-		}
-	case *TagsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(TagsPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*TagsPagedResponse).Pages
-			results = r.Result().(*TagsPagedResponse).Results
-			v.appendData(r.Result().(*TagsPagedResponse))
-		}
-	case *TokensPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(TokensPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*TokensPagedResponse).Pages
-			results = r.Result().(*TokensPagedResponse).Results
-			v.appendData(r.Result().(*TokensPagedResponse))
+		return nil
+	})
+}
+
+// pagedResponse is implemented by the (endpoint-specific)PagedResponse types
+// returned by List endpoints. The endpoint of each is found by its type in the
+// Client's Resource registry (see NewResource), so a new List endpoint needs
+// only to register its PagedResponse along with its Resource.
+type pagedResponse interface {
+	// pagination returns the embedded PageOptions describing the page
+	pagination() *PageOptions
+	// appendData appends the Data of another page of the same type
+	appendData(page pagedResponse)
+}
+
+// pagination is promoted to each PagedResponse embedding *PageOptions
+func (p *PageOptions) pagination() *PageOptions {
+	return p
+}
+
+// newPage returns an empty PagedResponse of the same type as i
+func newPage(i pagedResponse) pagedResponse {
+	return reflect.New(reflect.TypeOf(i).Elem()).Interface().(pagedResponse)
+}
+
+// pagedResource returns the Resource registered to list the PagedResponse i
+func (c *Client) pagedResource(i pagedResponse) (*Resource, error) {
+	t := reflect.TypeOf(i).Elem()
+
+	var found *Resource
+	for _, r := range c.resources {
+		if r.pagedType != t {
+			continue
 		}
-	case *UsersPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(UsersPagedResponse{}).Get(v.endpoint(c))); err == nil {
-			pages = r.Result().(*UsersPagedResponse).Pages
-			results = r.Result().(*UsersPagedResponse).Results
-			v.appendData(r.Result().(*UsersPagedResponse))
+		if found != nil {
+			return nil, NewError(fmt.Sprintf("%s is registered by both the %s and %s resources", t.Name(), found.name, r.name))
 		}
-	/**
-	case ProfileAppsPagedResponse:
-	case ProfileWhitelistPagedResponse:
-	case ManagedContactsPagedResponse:
-	case ManagedCredentialsPagedResponse:
-	case ManagedIssuesPagedResponse:
-	case ManagedLinodeSettingsPagedResponse:
-	case ManagedServicesPagedResponse:
-	**/
-	default:
-		log.Fatalf("listHelper interface{} %+v used", i)
+		found = r
 	}
 
-	if err != nil {
-		return err
+	if found == nil {
+		return nil, NewError(fmt.Sprintf("No resource is registered for %s", t.Name()))
 	}
+	return found, nil
+}
 
-	if opts == nil {
-		for page := 2; page <= pages; page++ {
-			if err := c.listHelper(ctx, i, &ListOptions{PageOptions: &PageOptions{Page: page}}); err != nil {
-				return err
+// listPages fetches pages 2 through pages of a List endpoint into i, an
+// (endpoint-specific)PagedResponse, using fetch to get each page. When the Client
+// allows, the pages are fetched concurrently and then appended in page order.
+func (c *Client) listPages(ctx context.Context, i pagedResponse, pages int, opts *ListOptions, fetch func(ctx context.Context, i pagedResponse, opts *ListOptions) error) error {
+	pageOptions := func(page int) *ListOptions {
+		o := &ListOptions{PageOptions: &PageOptions{Page: page}}
+		if opts != nil {
+			o.Filter = opts.Filter
+			if opts.PageOptions != nil {
+				o.PageSize = opts.PageSize
 			}
 		}
-	} else {
-		if opts.PageOptions == nil {
-			opts.PageOptions = &PageOptions{}
-		}
+		return o
+	}
 
-		if opts.Page == 0 {
-			for page := 2; page <= pages; page++ {
-				opts.Page = page
-				if err := c.listHelper(ctx, i, opts); err != nil {
-					return err
-				}
+	workers := c.pageWorkers()
+	if workers <= 1 || pages <= 2 {
+		for page := 2; page <= pages; page++ {
+			if err := fetch(ctx, i, pageOptions(page)); err != nil {
+				return err
 			}
 		}
-		opts.Results = results
-		opts.Pages = pages
+		return nil
 	}
-
-	return nil
-}
-
-// listHelperWithID abstracts fetching and pagination for GET endpoints that
-// require an Id (second level endpoints).
-// When opts (or opts.Page) is nil, all pages will be fetched and
-// returned in a single (endpoint-specific)PagedResponse
-// opts.results and opts.pages will be updated from the API response
-func (c *Client) listHelperWithID(ctx context.Context, i interface{}, idRaw interface{}, opts *ListOptions) error {
-	req := c.R(ctx)
-	if opts != nil && opts.Page > 0 {
-		req.SetQueryParam("page", strconv.Itoa(opts.Page))
+	if workers > pages-1 {
+		workers = pages - 1
 	}
 
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	var (
-		err     error
-		pages   int
-		results int
-		r       *resty.Response
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		firstErr  error
+		responses = make([]pagedResponse, pages+1)
+		jobs      = make(chan int)
 	)
 
-	id, _ := idRaw.(int)
-
-	if opts != nil && len(opts.Filter) > 0 {
-		req.SetHeader("X-Filter", opts.Filter)
-	}
-
-	switch v := i.(type) {
-	case *InvoiceItemsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(InvoiceItemsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
-			pages = r.Result().(*InvoiceItemsPagedResponse).Pages
-			results = r.Result().(*InvoiceItemsPagedResponse).Results
-			v.appendData(r.Result().(*InvoiceItemsPagedResponse))
-		}
-	case *DomainRecordsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(DomainRecordsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
-			response, ok := r.Result().(*DomainRecordsPagedResponse)
-			if !ok {
-				return fmt.Errorf("response is not a *DomainRecordsPagedResponse")
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range jobs {
+				response := newPage(i)
+				if err := fetch(ctx, response, pageOptions(page)); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					cancel()
+					continue
+				}
+				responses[page] = response
 			}
-			pages = response.Pages
-			results = response.Results
-			v.appendData(response)
-		}
-	case *InstanceConfigsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(InstanceConfigsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
-			pages = r.Result().(*InstanceConfigsPagedResponse).Pages
-			results = r.Result().(*InstanceConfigsPagedResponse).Results
-			v.appendData(r.Result().(*InstanceConfigsPagedResponse))
-		}
-	case *InstanceDisksPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(InstanceDisksPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
-			pages = r.Result().(*InstanceDisksPagedResponse).Pages
-			results = r.Result().(*InstanceDisksPagedResponse).Results
-			v.appendData(r.Result().(*InstanceDisksPagedResponse))
-		}
-	case *NodeBalancerConfigsPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(NodeBalancerConfigsPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
-			pages = r.Result().(*NodeBalancerConfigsPagedResponse).Pages
-			results = r.Result().(*NodeBalancerConfigsPagedResponse).Results
-			v.appendData(r.Result().(*NodeBalancerConfigsPagedResponse))
-		}
-	case *InstanceVolumesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(InstanceVolumesPagedResponse{}).Get(v.endpointWithID(c, id))); err == nil {
-			pages = r.Result().(*InstanceVolumesPagedResponse).Pages
-			results = r.Result().(*InstanceVolumesPagedResponse).Results
-			v.appendData(r.Result().(*InstanceVolumesPagedResponse))
-		}
-	case *TaggedObjectsPagedResponse:
-		idStr := idRaw.(string)
+		}()
+	}
 
-		if r, err = coupleAPIErrors(req.SetResult(TaggedObjectsPagedResponse{}).Get(v.endpointWithID(c, idStr))); err == nil {
-			pages = r.Result().(*TaggedObjectsPagedResponse).Pages
-			results = r.Result().(*TaggedObjectsPagedResponse).Results
-			v.appendData(r.Result().(*TaggedObjectsPagedResponse))
-		}
-	/**
-	case TicketAttachmentsPagedResponse:
-		if r, err = req.SetResult(v).Get(v.endpoint(c)); r.Error() != nil {
-			return NewError(r)
-		} else if err == nil {
-			pages = r.Result().(*TicketAttachmentsPagedResponse).Pages
-			results = r.Result().(*TicketAttachmentsPagedResponse).Results
-			v.appendData(r.Result().(*TicketAttachmentsPagedResponse))
+queue:
+	for page := 2; page <= pages; page++ {
+		select {
+		case jobs <- page:
+		case <-ctx.Done():
+			break queue
 		}
-	case TicketRepliesPagedResponse:
-		if r, err = req.SetResult(v).Get(v.endpoint(c)); r.Error() != nil {
-			return NewError(r)
-		} else if err == nil {
-			pages = r.Result().(*TicketRepliesPagedResponse).Pages
-			results = r.Result().(*TicketRepliesPagedResponse).Results
-			v.appendData(r.Result().(*TicketRepliesPagedResponse))
-		}
-	**/
-	default:
-		log.Fatalf("Unknown listHelperWithID interface{} %T used", i)
 	}
+	close(jobs)
+	wg.Wait()
 
-	if err != nil {
-		return err
+	if firstErr != nil {
+		return firstErr
 	}
 
-	if opts == nil {
-		for page := 2; page <= pages; page++ {
-			if err := c.listHelperWithID(ctx, i, id, &ListOptions{PageOptions: &PageOptions{Page: page}}); err != nil {
-				return err
-			}
-		}
-	} else {
-		if opts.PageOptions == nil {
-			opts.PageOptions = &PageOptions{}
-		}
-		if opts.Page == 0 {
-			for page := 2; page <= pages; page++ {
-				opts.Page = page
-				if err := c.listHelperWithID(ctx, i, id, opts); err != nil {
-					return err
-				}
+	// Pages are only missing when the parent context ended before they were queued
+	for page := 2; page <= pages; page++ {
+		if responses[page] == nil {
+			if err := parent.Err(); err != nil {
+				return NewError(err)
 			}
+			return NewError(fmt.Sprintf("page %d of %d was not fetched", page, pages))
 		}
-		opts.Results = results
-		opts.Pages = pages
 	}
-
+	for page := 2; page <= pages; page++ {
+		i.appendData(responses[page])
+	}
 	return nil
 }
 
+// listHelper abstracts fetching and pagination for GET endpoints that
+// do not require any Ids (top level endpoints).
+// When opts (or opts.Page) is nil, all pages will be fetched and
+// returned in a single (endpoint-specific)PagedResponse
+// opts.results and opts.pages will be updated from the API response
+func (c *Client) listHelper(ctx context.Context, operation string, i pagedResponse, opts *ListOptions) error {
+	return c.listPaged(ctx, operation, i, opts)
+}
+
+// listHelperWithID abstracts fetching and pagination for GET endpoints that
+// require an Id (second level endpoints).
+// When opts (or opts.Page) is nil, all pages will be fetched and
+// returned in a single (endpoint-specific)PagedResponse
+// opts.results and opts.pages will be updated from the API response
+func (c *Client) listHelperWithID(ctx context.Context, operation string, i pagedResponse, idRaw interface{}, opts *ListOptions) error {
+	return c.listPaged(ctx, operation, i, opts, idRaw)
+}
+
 // listHelperWithTwoIDs abstracts fetching and pagination for GET endpoints that
 // require twos IDs (third level endpoints).
 // When opts (or opts.Page) is nil, all pages will be fetched and
 // returned in a single (endpoint-specific)PagedResponse
 // opts.results and opts.pages will be updated from the API response
-func (c *Client) listHelperWithTwoIDs(ctx context.Context, i interface{}, firstID, secondID int, opts *ListOptions) error {
-	req := c.R(ctx)
-	if opts != nil && opts.Page > 0 {
-		req.SetQueryParam("page", strconv.Itoa(opts.Page))
-	}
+func (c *Client) listHelperWithTwoIDs(ctx context.Context, operation string, i pagedResponse, firstID, secondID int, opts *ListOptions) error {
+	return c.listPaged(ctx, operation, i, opts, firstID, secondID)
+}
 
-	var (
-		err     error
-		pages   int
-		results int
-		r       *resty.Response
-	)
+// listPaged fetches the (endpoint-specific)PagedResponse i from the endpoint of
+// the Resource registered for its type, rendered with the params (IDs). The
+// operation is the name of the Client method listing, such as "ListInstances".
+func (c *Client) listPaged(ctx context.Context, operation string, i pagedResponse, opts *ListOptions, params ...interface{}) (err error) {
+	resource, err := c.pagedResource(i)
+	if err != nil {
+		return err
+	}
 
-	if opts != nil && len(opts.Filter) > 0 {
-		req.SetHeader("X-Filter", opts.Filter)
+	ids := make([]string, len(params))
+	for n, param := range params {
+		ids[n] = fmt.Sprint(param)
 	}
+	ctx, span := c.transport.current().startOperationSpan(withOperation(ctx, operation), resourceAttributes(resource.name, ids)...)
+	defer func() { span.end(err) }()
 
-	switch v := i.(type) {
-	case *NodeBalancerNodesPagedResponse:
-		if r, err = coupleAPIErrors(req.SetResult(NodeBalancerNodesPagedResponse{}).Get(v.endpointWithTwoIDs(c, firstID, secondID))); err == nil {
-			pages = r.Result().(*NodeBalancerNodesPagedResponse).Pages
-			results = r.Result().(*NodeBalancerNodesPagedResponse).Results
-			v.appendData(r.Result().(*NodeBalancerNodesPagedResponse))
-		}
+	endpoint, err := resource.endpointWithParams(params...)
+	if err != nil {
+		return err
+	}
 
-	default:
-		log.Fatalf("Unknown listHelperWithTwoIDs interface{} %T used", i)
+	req := c.request(ctx, operation)
+	if err = applyListOptions(req, opts); err != nil {
+		return err
 	}
 
-	if err != nil {
+	page := newPage(i)
+	if _, err = coupleAPIErrors(req.SetResult(page).Get(endpoint)); err != nil {
 		return err
 	}
+	i.appendData(page)
 
-	if opts == nil {
-		for page := 2; page <= pages; page++ {
-			if err := c.listHelper(ctx, i, &ListOptions{PageOptions: &PageOptions{Page: page}}); err != nil {
-				return err
-			}
+	var pages, results int
+	if pagination := page.pagination(); pagination != nil {
+		pages = pagination.Pages
+		results = pagination.Results
+	}
+
+	if opts == nil || opts.PageOptions == nil || opts.Page == 0 {
+		err = c.listPages(ctx, i, pages, opts, func(ctx context.Context, i pagedResponse, opts *ListOptions) error {
+			return c.listPaged(ctx, operation, i, opts, params...)
+		})
+		if err != nil {
+			return err
 		}
-	} else {
+	}
+
+	if opts != nil {
 		if opts.PageOptions == nil {
 			opts.PageOptions = &PageOptions{}
 		}
-		if opts.Page == 0 {
-			for page := 2; page <= pages; page++ {
-				opts.Page = page
-				if err := c.listHelperWithTwoIDs(ctx, i, firstID, secondID, opts); err != nil {
-					return err
-				}
-			}
-		}
 		opts.Results = results
 		opts.Pages = pages
 	}
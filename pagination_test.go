@@ -0,0 +1,245 @@
+package linodego_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+// pagedEventsHandler serves pages of events with perPage events on each page
+func pagedEventsHandler(pages int, perPage int, requests *int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		data := ""
+		for i := 0; i < perPage; i++ {
+			if i > 0 {
+				data += ","
+			}
+			data += fmt.Sprintf(`{"id": %d, "created": "2018-01-02T03:04:05", "time_remaining": null}`, (page-1)*perPage+i+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"page": %d, "pages": %d, "results": %d, "data": [%s]}`, page, pages, pages*perPage, data)
+	})
+}
+
+func TestListEventsFunc(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(3, 2, &requests))
+	defer teardown()
+
+	var ids []int
+	err := client.ListEventsFunc(context.Background(), nil, func(event Event) error {
+		ids = append(ids, event.ID)
+		if event.Created == nil {
+			t.Errorf("Expected event dates to be parsed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error iterating events, got %v", err)
+	}
+	if len(ids) != 6 || ids[0] != 1 || ids[5] != 6 {
+		t.Errorf("Expected events 1-6 in order, got %v", ids)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestListEventsFunc_stopEarly(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(5, 2, &requests))
+	defer teardown()
+
+	opts := NewListOptions(0, "")
+	var visited int
+	err := client.ListEventsFunc(context.Background(), opts, func(event Event) error {
+		visited++
+		if opts.Pages != 5 || opts.Results != 10 {
+			t.Errorf("Expected opts to report 5 pages and 10 results, got %d and %d", opts.Pages, opts.Results)
+		}
+		if event.ID == 3 {
+			if opts.Page != 2 {
+				t.Errorf("Expected event 3 to be on page 2, got page %d", opts.Page)
+			}
+			return ErrStopIteration
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error iterating events, expected ErrStopIteration to stop without error, got %v", err)
+	}
+	if visited != 3 {
+		t.Errorf("Expected 3 events to be visited, got %d", visited)
+	}
+	if requests != 2 {
+		t.Errorf("Expected only 2 pages to be fetched, got %d", requests)
+	}
+}
+
+func TestListEventsFunc_error(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(3, 2, &requests))
+	defer teardown()
+
+	stop := fmt.Errorf("caller error")
+	err := client.ListEventsFunc(context.Background(), NewListOptions(2, ""), func(event Event) error {
+		if event.ID != 3 {
+			t.Errorf("Expected iteration to start on page 2, got event %d", event.ID)
+		}
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Expected the caller's error to be returned, got %v", err)
+	}
+}
+
+func TestListEvents_concurrentPages(t *testing.T) {
+	var requests, inFlight, maxInFlight int32
+	events := pagedEventsHandler(6, 2, &requests)
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Filter") != `{"seen": false}` {
+			t.Errorf("Expected every page request to be filtered, got %q", r.Header.Get("X-Filter"))
+		}
+
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		// Later pages are served first, so the results must be reordered
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		time.Sleep(time.Duration(10-page) * 5 * time.Millisecond)
+		events.ServeHTTP(w, r)
+	}))
+	defer teardown()
+
+	client.SetPageConcurrency(3)
+
+	opts := NewListOptions(0, `{"seen": false}`)
+	result, err := client.ListEvents(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Error listing events, got %v", err)
+	}
+	if len(result) != 12 {
+		t.Fatalf("Expected 12 events, got %d", len(result))
+	}
+	for i, event := range result {
+		if event.ID != i+1 {
+			t.Fatalf("Expected events in page order, got event %d at position %d", event.ID, i)
+		}
+	}
+	if opts.Pages != 6 || opts.Results != 12 {
+		t.Errorf("Expected opts to report 6 pages and 12 results, got %d and %d", opts.Pages, opts.Results)
+	}
+	if requests != 6 {
+		t.Errorf("Expected 6 page requests, got %d", requests)
+	}
+	if maxInFlight < 2 || maxInFlight > 3 {
+		t.Errorf("Expected between 2 and 3 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestListEvents_concurrentPagesError(t *testing.T) {
+	var requests int32
+	events := pagedEventsHandler(6, 2, &requests)
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "4" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Internal Server Error"}]}`))
+			return
+		}
+		events.ServeHTTP(w, r)
+	}))
+	defer teardown()
+
+	client.SetPageConcurrency(4)
+
+	result, err := client.ListEvents(context.Background(), nil)
+	if !IsServerError(err) {
+		t.Errorf("Expected the failed page's error, got %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected no events when a page fails, got %d", len(result))
+	}
+}
+
+func TestListEvents_concurrentPagesCanceledAfterFetching(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(6, 2, &requests))
+	defer teardown()
+
+	client.SetPageConcurrency(5)
+
+	// the context is canceled once every page has been received
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var responses int32
+	client.OnAfterResponse(func(ctx context.Context, resp *ResponseInfo) {
+		if atomic.AddInt32(&responses, 1) == 6 {
+			cancel()
+		}
+	})
+
+	result, err := client.ListEvents(ctx, nil)
+	if err != nil || len(result) != 12 {
+		t.Errorf("Expected the 12 events fetched before the cancellation, got %d and %v", len(result), err)
+	}
+}
+
+func TestListDomainRecords_pageSize(t *testing.T) {
+	var requests int32
+	records := pagedEventsHandler(3, 2, &requests)
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if pageSize := r.URL.Query().Get("page_size"); pageSize != "500" {
+			t.Errorf("Expected every page request to have page_size 500, got %q", pageSize)
+		}
+		records.ServeHTTP(w, r)
+	}))
+	defer teardown()
+
+	opts := &ListOptions{PageOptions: &PageOptions{PageSize: MaxPageSize}}
+	result, err := client.ListDomainRecords(context.Background(), 1234, opts)
+	if err != nil {
+		t.Fatalf("Error listing domain records, got %v", err)
+	}
+	if len(result) != 6 {
+		t.Errorf("Expected 6 domain records, got %d", len(result))
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestListEvents_invalidPageSize(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(1, 2, &requests))
+	defer teardown()
+
+	for _, pageSize := range []int{-1, MinPageSize - 1, MaxPageSize + 1} {
+		opts := &ListOptions{PageOptions: &PageOptions{Page: 1, PageSize: pageSize}}
+		if _, err := client.ListEvents(context.Background(), opts); err == nil {
+			t.Errorf("Expected PageSize %d to be rejected", pageSize)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests with an invalid PageSize, got %d", requests)
+	}
+}
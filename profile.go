@@ -10,7 +10,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 )
 
 // LishAuthMethod constants start with AuthMethod and include Linode API Lish Authentication Methods
@@ -83,7 +82,7 @@ func (c *Client) GetProfile(ctx context.Context) (*Profile, error) {
 		return nil, err
 	}
 
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Profile{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetProfile").SetResult(&Profile{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -98,9 +97,9 @@ func (c *Client) UpdateProfile(ctx context.Context, updateOpts ProfileUpdateOpti
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Profile{})
+	req := c.request(ctx, "UpdateProfile").SetResult(&Profile{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
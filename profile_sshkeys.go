@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -46,24 +45,15 @@ type SSHKeysPagedResponse struct {
 	Data []SSHKey `json:"data"`
 }
 
-// endpoint gets the endpoint URL for SSHKey
-func (SSHKeysPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.SSHKeys.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends SSHKeys when processing paginated SSHKey responses
-func (resp *SSHKeysPagedResponse) appendData(r *SSHKeysPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *SSHKeysPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*SSHKeysPagedResponse).Data...)
 }
 
 // ListSSHKeys lists SSHKeys
 func (c *Client) ListSSHKeys(ctx context.Context, opts *ListOptions) ([]SSHKey, error) {
 	response := SSHKeysPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListSSHKeys", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -73,6 +63,13 @@ func (c *Client) ListSSHKeys(ctx context.Context, opts *ListOptions) ([]SSHKey,
 	return response.Data, nil
 }
 
+// ListSSHKeysFunc calls fn with each SSHKey, fetching pages as they are needed
+func (c *Client) ListSSHKeysFunc(ctx context.Context, opts *ListOptions, fn func(SSHKey) error) error {
+	return c.listFunc(ctx, "ListSSHKeysFunc", &SSHKeysPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*SSHKey).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *SSHKey) fixDates() *SSHKey {
 	i.Created, _ = parseDates(i.CreatedStr)
@@ -86,7 +83,7 @@ func (c *Client) GetSSHKey(ctx context.Context, id int) (*SSHKey, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&SSHKey{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetSSHKey").SetResult(&SSHKey{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -101,9 +98,9 @@ func (c *Client) CreateSSHKey(ctx context.Context, createOpts SSHKeyCreateOption
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&SSHKey{})
+	req := c.request(ctx, "CreateSSHKey").SetResult(&SSHKey{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -128,9 +125,9 @@ func (c *Client) UpdateSSHKey(ctx context.Context, id int, updateOpts SSHKeyUpda
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&SSHKey{})
+	req := c.request(ctx, "UpdateSSHKey").SetResult(&SSHKey{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -154,7 +151,7 @@ func (c *Client) DeleteSSHKey(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteSSHKey").Delete(e))
 	return err
 
 }
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -13,13 +12,13 @@ type Token struct {
 	ID int `json:"id"`
 
 	// The scopes this token was created with. These define what parts of the Account the token can be used to access. Many command-line tools, such as the Linode CLI, require tokens with access to *. Tokens with more restrictive scopes are generally more secure.
-	Scopes string `json:"scopes"`
+	Scopes Scopes `json:"scopes"`
 
 	// This token's label. This is for display purposes only, but can be used to more easily track what you're using each token for. (1-100 Characters)
 	Label string `json:"label"`
 
 	// The token used to access the API. When the token is created, the full token is returned here. Otherwise, only the first 16 characters are returned.
-	Token string `json:"token"`
+	Token string `json:"token" redact:"true"`
 
 	// The date and time this token was created.
 	Created    *time.Time `json:"-"`
@@ -33,7 +32,7 @@ type Token struct {
 // TokenCreateOptions fields are those accepted by CreateToken
 type TokenCreateOptions struct {
 	// The scopes this token was created with. These define what parts of the Account the token can be used to access. Many command-line tools, such as the Linode CLI, require tokens with access to *. Tokens with more restrictive scopes are generally more secure.
-	Scopes string `json:"scopes"`
+	Scopes Scopes `json:"scopes"`
 
 	// This token's label. This is for display purposes only, but can be used to more easily track what you're using each token for. (1-100 Characters)
 	Label string `json:"label"`
@@ -52,7 +51,10 @@ type TokenUpdateOptions struct {
 func (i Token) GetCreateOptions() (o TokenCreateOptions) {
 	o.Label = i.Label
 	o.Expiry = copyTime(i.Expiry)
-	o.Scopes = i.Scopes
+	o.Scopes = make(Scopes, len(i.Scopes))
+	for resource, level := range i.Scopes {
+		o.Scopes[resource] = level
+	}
 	return
 }
 
@@ -68,24 +70,15 @@ type TokensPagedResponse struct {
 	Data []Token `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Token
-func (TokensPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Tokens.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Tokens when processing paginated Token responses
-func (resp *TokensPagedResponse) appendData(r *TokensPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *TokensPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*TokensPagedResponse).Data...)
 }
 
 // ListTokens lists Tokens
 func (c *Client) ListTokens(ctx context.Context, opts *ListOptions) ([]Token, error) {
 	response := TokensPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListTokens", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -95,6 +88,13 @@ func (c *Client) ListTokens(ctx context.Context, opts *ListOptions) ([]Token, er
 	return response.Data, nil
 }
 
+// ListTokensFunc calls fn with each Token, fetching pages as they are needed
+func (c *Client) ListTokensFunc(ctx context.Context, opts *ListOptions, fn func(Token) error) error {
+	return c.listFunc(ctx, "ListTokensFunc", &TokensPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Token).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *Token) fixDates() *Token {
 	i.Created, _ = parseDates(i.CreatedStr)
@@ -109,7 +109,7 @@ func (c *Client) GetToken(ctx context.Context, id int) (*Token, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Token{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetToken").SetResult(&Token{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -124,12 +124,12 @@ func (c *Client) CreateToken(ctx context.Context, createOpts TokenCreateOptions)
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Token{})
+	req := c.request(ctx, "CreateToken").SetResult(&Token{})
 
 	// Format the Time as a string to meet the ISO8601 requirement
 	createOptsFixed := struct {
 		Label  string  `json:"label"`
-		Scopes string  `json:"scopes"`
+		Scopes Scopes  `json:"scopes"`
 		Expiry *string `json:"expiry"`
 	}{}
 	createOptsFixed.Label = createOpts.Label
@@ -139,7 +139,7 @@ func (c *Client) CreateToken(ctx context.Context, createOpts TokenCreateOptions)
 		createOptsFixed.Expiry = &iso8601Expiry
 	}
 
-	if bodyData, err := json.Marshal(createOptsFixed); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOptsFixed); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -164,9 +164,9 @@ func (c *Client) UpdateToken(ctx context.Context, id int, updateOpts TokenUpdate
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&Token{})
+	req := c.request(ctx, "UpdateToken").SetResult(&Token{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -190,6 +190,6 @@ func (c *Client) DeleteToken(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteToken").Delete(e))
 	return err
 }
@@ -128,7 +128,7 @@ func setupProfileToken(t *testing.T, fixturesYaml string, ttl *time.Time) (*Clie
 	client, fixtureTeardown := createTestClient(t, fixturesYaml)
 
 	// This scope must be <= the scope used for testing
-	limitedTestScope := "linodes:read_only"
+	limitedTestScope := Scopes{"linodes": ScopeReadOnly}
 
 	createOpts := TokenCreateOptions{
 		Label:  "linodego-test-token",
@@ -0,0 +1,214 @@
+package linodego
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is a request budget of Requests per Interval
+type RateLimit struct {
+	Requests int
+	Interval time.Duration
+}
+
+// DefaultRateLimits returns conservative per-method budgets for the client-side
+// rate limiter. The limiter adopts the limits reported by the API in the
+// X-RateLimit-Limit header once a response has been received.
+func DefaultRateLimits() map[string]RateLimit {
+	return map[string]RateLimit{
+		http.MethodGet:    {Requests: 800, Interval: time.Minute},
+		http.MethodPost:   {Requests: 40, Interval: time.Minute},
+		http.MethodPut:    {Requests: 200, Interval: time.Minute},
+		http.MethodDelete: {Requests: 200, Interval: time.Minute},
+	}
+}
+
+// SetRateLimits enables the client-side rate limiter using the budgets given for each
+// HTTP method. Requests to each class of endpoints (method and path, ignoring IDs) draw
+// from their own token bucket and block, until their context is done, rather than
+// exceeding the budget. A method without a budget is not limited. Use nil to disable
+// the limiter.
+func (c *Client) SetRateLimits(limits map[string]RateLimit) *Client {
+	var limiter *rateLimiter
+	if limits != nil {
+		limiter = newRateLimiter(limits)
+	}
+	c.transport.update(func(s *transportSettings) { s.rateLimiter = limiter })
+	return c
+}
+
+const (
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// endpointClass identifies the rate limit bucket of a request, replacing each
+// numeric ID segment of its path
+func endpointClass(method string, path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if len(segment) > 0 && strings.Trim(segment, "0123456789") == "" {
+			segments[i] = "{id}"
+		}
+	}
+	return method + " " + strings.Join(segments, "/")
+}
+
+// rateLimiter keeps a token bucket for each class of endpoints
+type rateLimiter struct {
+	mu      sync.Mutex
+	limits  map[string]RateLimit
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiter(limits map[string]RateLimit) *rateLimiter {
+	copied := make(map[string]RateLimit, len(limits))
+	for method, limit := range limits {
+		copied[method] = limit
+	}
+	return &rateLimiter{limits: copied, buckets: make(map[string]*tokenBucket)}
+}
+
+// bucket returns the token bucket for the request, or nil if the method is not limited.
+// The caller must hold the lock.
+func (l *rateLimiter) bucket(req *http.Request, now time.Time) *tokenBucket {
+	limit, ok := l.limits[req.Method]
+	if !ok || limit.Requests <= 0 || limit.Interval <= 0 {
+		return nil
+	}
+
+	class := endpointClass(req.Method, req.URL.Path)
+	b, ok := l.buckets[class]
+	if !ok {
+		b = newTokenBucket(limit, now)
+		l.buckets[class] = b
+	}
+	return b
+}
+
+// wait blocks until the request may be sent or its context is done.
+// It returns the time spent waiting.
+func (l *rateLimiter) wait(req *http.Request) (time.Duration, error) {
+	var waited time.Duration
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		var delay time.Duration
+		if b := l.bucket(req, now); b != nil {
+			delay = b.reserve(now)
+		}
+		l.mu.Unlock()
+
+		if delay <= 0 {
+			return waited, nil
+		}
+
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return waited, err
+		}
+		waited += delay
+	}
+}
+
+// update adjusts the request's bucket to the budget reported by the API
+func (l *rateLimiter) update(req *http.Request, resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b := l.bucket(req, now)
+	if b == nil {
+		return
+	}
+
+	header := resp.Header
+	if limit, err := strconv.Atoi(header.Get(rateLimitLimitHeader)); err == nil && limit > 0 {
+		b.setCapacity(limit)
+	}
+
+	if remaining, err := strconv.Atoi(header.Get(rateLimitRemainingHeader)); err == nil && remaining >= 0 {
+		b.tokens = math.Min(b.tokens, float64(remaining))
+
+		reset, err := strconv.ParseInt(header.Get(rateLimitResetHeader), 10, 64)
+		if err == nil && remaining == 0 {
+			b.blockUntil(time.Unix(reset, 0))
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		b.tokens = 0
+		if delay, ok := parseRetryAfter(header.Get("Retry-After")); ok {
+			b.blockUntil(now.Add(delay))
+		}
+	}
+}
+
+// tokenBucket refills continuously at capacity tokens per interval
+type tokenBucket struct {
+	capacity float64
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+	until    time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity: float64(limit.Requests),
+		interval: limit.Interval,
+		tokens:   float64(limit.Requests),
+		last:     now,
+	}
+}
+
+// rate is the number of tokens added per second
+func (b *tokenBucket) rate() float64 {
+	return b.capacity / b.interval.Seconds()
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed.Seconds()*b.rate())
+		b.last = now
+	}
+}
+
+// reserve takes a token, returning zero, or returns how long to wait before trying again
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if !b.until.IsZero() {
+		if now.Before(b.until) {
+			return b.until.Sub(now)
+		}
+		// The API's budget has been reset
+		b.tokens = b.capacity
+		b.last = now
+		b.until = time.Time{}
+	}
+
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate() * float64(time.Second))
+}
+
+func (b *tokenBucket) setCapacity(capacity int) {
+	b.capacity = float64(capacity)
+	b.tokens = math.Min(b.tokens, b.capacity)
+}
+
+// blockUntil prevents tokens from being taken before the given time,
+// when the bucket will be refilled
+func (b *tokenBucket) blockUntil(t time.Time) {
+	b.tokens = 0
+	if t.After(b.until) {
+		b.until = t
+	}
+}
@@ -0,0 +1,111 @@
+package linodego_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+func TestRateLimits_budgetPerMethod(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"label": "limited"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"page": 1, "pages": 1, "results": 0, "data": []}`))
+	}))
+	defer teardown()
+
+	client.SetRateLimits(map[string]RateLimit{
+		http.MethodGet: {Requests: 1, Interval: 100 * time.Millisecond},
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.ListTypes(context.Background(), nil); err != nil {
+			t.Fatalf("Error listing types, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected 3 GET requests at 1 per 100ms to take at least 150ms, took %v", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.CreateTag(context.Background(), TagCreateOptions{Label: "limited"}); err != nil {
+			t.Fatalf("Error creating tag, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected POST requests without a budget not to wait, took %v", elapsed)
+	}
+}
+
+func TestRateLimits_exhaustedByHeaders(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "800")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		_, _ = w.Write([]byte(`{"page": 1, "pages": 1, "results": 0, "data": []}`))
+	}))
+	defer teardown()
+
+	client.SetRateLimits(DefaultRateLimits())
+
+	if _, err := client.ListTypes(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing types, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.ListTypes(ctx, nil); err == nil {
+		t.Errorf("Error listing types, expected the exhausted budget to block until the context deadline")
+	}
+	if requests != 1 {
+		t.Errorf("Expected the blocked request not to be sent, got %d requests", requests)
+	}
+
+	// Other endpoint classes draw from their own buckets
+	if _, err := client.ListRegions(context.Background(), nil); err != nil {
+		t.Errorf("Error listing regions, expected a separate budget, got %v", err)
+	}
+}
+
+func TestRateLimits_consecutiveIDs(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer teardown()
+
+	client.SetRateLimits(DefaultRateLimits())
+
+	if _, err := client.R(context.Background()).Get("linode/instances/1/2"); err != nil {
+		t.Fatalf("Error requesting linode/instances/1/2, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// both IDs are replaced, so the paths share a bucket
+	if _, err := client.R(ctx).Get("linode/instances/3/4"); err == nil {
+		t.Errorf("Error requesting linode/instances/3/4, expected the exhausted budget of its class to block it")
+	}
+	if requests != 1 {
+		t.Errorf("Expected the blocked request not to be sent, got %d requests", requests)
+	}
+}
@@ -0,0 +1,72 @@
+package linodego
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// redacted replaces secrets in debug output, log messages and errors
+const redacted = "[REDACTED]"
+
+// redactedTypes are the types sent to or received from the API with secret
+// fields. Secret fields are marked with a `redact:"true"` tag.
+var redactedTypes = []interface{}{
+	InstanceCreateOptions{},
+	InstanceRebuildOptions{},
+	InstanceDiskCreateOptions{},
+	instanceDiskPasswordResetOptions{},
+	NodeBalancerConfig{},
+	NodeBalancerConfigCreateOptions{},
+	NodeBalancerConfigRebuildOptions{},
+	OAuthClient{},
+	Token{},
+}
+
+var (
+	// sensitiveFieldPattern matches the JSON string values of the secret fields
+	sensitiveFieldPattern = regexp.MustCompile(`"(` + strings.Join(sensitiveFields(redactedTypes...), "|") + `)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// authorizationPattern matches the credentials of Authorization headers, as
+	// formatted by resty's debug output
+	authorizationPattern = regexp.MustCompile(`(?im)^(\s*Authorization\s*:\s*)(?:(Bearer|Basic|token)\s+)?\S.*$`)
+)
+
+// sensitiveFields returns the sorted JSON names of the fields of the struct
+// types marked with a `redact:"true"` tag
+func sensitiveFields(types ...interface{}) []string {
+	names := map[string]bool{}
+	for _, v := range types {
+		t := reflect.TypeOf(v)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Tag.Get("redact") != "true" {
+				continue
+			}
+			if name := strings.Split(field.Tag.Get("json"), ",")[0]; len(name) > 0 {
+				names[regexp.QuoteMeta(name)] = true
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(names))
+	for name := range names {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// redact masks the secrets in s, such as the values of secret JSON fields and
+// the credentials of Authorization headers
+func redact(s string) string {
+	s = sensitiveFieldPattern.ReplaceAllString(s, `"$1"$2"`+redacted+`"`)
+	return authorizationPattern.ReplaceAllStringFunc(s, func(line string) string {
+		m := authorizationPattern.FindStringSubmatch(line)
+		if len(m[2]) > 0 {
+			return m[1] + m[2] + " " + redacted
+		}
+		return m[1] + redacted
+	})
+}
@@ -0,0 +1,105 @@
+package linodego_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/linode/linodego"
+)
+
+// echoHandler responds to each request with its body, or with the secrets the
+// API returns. Bodies including "failing-" are echoed in an error page.
+func echoHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Contains(string(body), "failing-") {
+			// a proxy error page echoing the request
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprintf(w, "<html><body>Bad Gateway for %s</body></html>", body)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/account/oauth-clients"):
+			_, _ = w.Write([]byte(`{"id": "client", "secret": "oauth-client-secret-value"}`))
+		case strings.HasSuffix(r.URL.Path, "/profile/tokens"):
+			_, _ = w.Write([]byte(`{"id": 1, "scopes": "*", "token": "personal-access-token-value"}`))
+		case len(body) > 0:
+			_, _ = w.Write(body)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	})
+}
+
+func TestRedaction(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, echoHandler(t))
+	defer teardown()
+
+	logger := &testLogger{}
+	client.SetLogger(logger).SetDebug(true).SetToken("api-token-value")
+
+	secrets := []string{
+		"api-token-value",
+		"instance-root-pass-value",
+		"rebuild-root-pass-value",
+		"disk-root-pass-value",
+		"disk-password-value",
+		"nodebalancer-ssl-key-value",
+		"oauth-client-secret-value",
+		"personal-access-token-value",
+	}
+
+	ctx := context.Background()
+	if _, err := client.CreateInstance(ctx, InstanceCreateOptions{Region: "us-east", RootPass: "instance-root-pass-value"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RebuildInstance(ctx, 123, InstanceRebuildOptions{Image: "linode/debian9", RootPass: "rebuild-root-pass-value"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateInstanceDisk(ctx, 123, InstanceDiskCreateOptions{Label: "disk", RootPass: "disk-root-pass-value"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.PasswordResetInstanceDisk(ctx, 123, 456, "disk-password-value"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateNodeBalancerConfig(ctx, 123, NodeBalancerConfigCreateOptions{Port: 443, SSLKey: "nodebalancer-ssl-key-value"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateOAuthClient(ctx, OAuthClientCreateOptions{Label: "app"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateToken(ctx, TokenCreateOptions{Label: "token"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// errors describing unexpected responses include part of the body
+	_, err := client.CreateInstance(ctx, InstanceCreateOptions{Region: "us-east", RootPass: "failing-root-pass-value"})
+	if err == nil {
+		t.Fatal("Expected an error for an unexpected response")
+	}
+	if strings.Contains(err.Error(), "failing-root-pass-value") || !strings.Contains(err.Error(), "[REDACTED]") {
+		t.Errorf("Expected the root_pass to be redacted from the error, got %v", err)
+	}
+	secrets = append(secrets, "failing-root-pass-value")
+
+	output := logger.output()
+	if !strings.Contains(output, "[REDACTED]") {
+		t.Errorf("Expected the debug output to include redacted values, got:\n%s", output)
+	}
+	for _, secret := range secrets {
+		if strings.Contains(output, secret) {
+			t.Errorf("Expected %q to be redacted from the debug output, got:\n%s", secret, output)
+		}
+	}
+}
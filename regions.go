@@ -17,24 +17,15 @@ type RegionsPagedResponse struct {
 	Data []Region `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Region
-func (RegionsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Regions.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Regions when processing paginated Region responses
-func (resp *RegionsPagedResponse) appendData(r *RegionsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *RegionsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*RegionsPagedResponse).Data...)
 }
 
 // ListRegions lists Regions
 func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region, error) {
 	response := RegionsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListRegions", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -44,6 +35,13 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 	return response.Data, nil
 }
 
+// ListRegionsFunc calls fn with each Region, fetching pages as they are needed
+func (c *Client) ListRegionsFunc(ctx context.Context, opts *ListOptions, fn func(Region) error) error {
+	return c.listFunc(ctx, "ListRegionsFunc", &RegionsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Region).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *Region) fixDates() *Region {
 	return v
@@ -56,7 +54,7 @@ func (c *Client) GetRegion(ctx context.Context, id string) (*Region, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%s", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Region{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetRegion").SetResult(&Region{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"text/template"
 
 	"gopkg.in/resty.v1"
@@ -47,6 +49,7 @@ const (
 	profileName               = "profile"
 	managedName               = "managed"
 	tagsName                  = "tags"
+	taggedObjectsName         = "taggedobjects"
 	usersName                 = "users"
 	paymentsName              = "payments"
 
@@ -89,6 +92,7 @@ const (
 	profileEndpoint             = "profile"
 	managedEndpoint             = "managed"
 	tagsEndpoint                = "tags"
+	taggedObjectsEndpoint       = "tags/{{ .ID }}"
 	usersEndpoint               = "account/users"
 	notificationsEndpoint       = "account/notifications"
 	oauthClientsEndpoint        = "account/oauth-clients"
@@ -103,9 +107,15 @@ type Resource struct {
 	endpointTemplate *template.Template
 	R                func(ctx context.Context) *resty.Request
 	PR               func(ctx context.Context) *resty.Request
+	pagedType        reflect.Type
+	segments         []string
+
+	// beta is set for Resources only served by beta API versions
+	beta bool
 }
 
 // NewResource is the factory to create a new Resource struct. If it has a template string the useTemplate bool must be set.
+// The pagedType, when not nil, is the (endpoint-specific)PagedResponse listed from the Resource's endpoint.
 func NewResource(client *Client, name string, endpoint string, useTemplate bool, singleType interface{}, pagedType interface{}) *Resource {
 	var tmpl *template.Template
 
@@ -121,7 +131,87 @@ func NewResource(client *Client, name string, endpoint string, useTemplate bool,
 		return client.R(ctx).SetResult(pagedType)
 	}
 
-	return &Resource{name, endpoint, useTemplate, tmpl, r, pr}
+	var pt reflect.Type
+	if pagedType != nil {
+		pt = reflect.TypeOf(pagedType)
+	}
+
+	return &Resource{name, endpoint, useTemplate, tmpl, r, pr, pt, endpointSegments(endpoint), false}
+}
+
+// newBetaResource creates a Resource only served by beta API versions, whose
+// requests fail with ErrBetaRequired when made with another version
+func newBetaResource(client *Client, name string, endpoint string, useTemplate bool, singleType interface{}, pagedType interface{}) *Resource {
+	r := NewResource(client, name, endpoint, useTemplate, singleType, pagedType)
+	r.beta = true
+	return r
+}
+
+// endpointSegments splits an endpoint into its path segments, giving
+// templated segments, such as "{{ .ID }}", as ""
+func endpointSegments(endpoint string) []string {
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	for i, segment := range segments {
+		if strings.Contains(segment, "{{") {
+			segments[i] = ""
+		}
+	}
+	return segments
+}
+
+// matchPath reports whether the path segments of a request are for the
+// Resource's endpoint, or an item or action beneath it. The IDs given in the
+// path for the templated segments, and for the item (if any), are returned.
+func (r Resource) matchPath(path []string) (ids []string, ok bool) {
+	if len(path) < len(r.segments) {
+		return nil, false
+	}
+
+	for i, segment := range r.segments {
+		switch {
+		case len(segment) == 0:
+			ids = append(ids, path[i])
+		case segment != path[i]:
+			return nil, false
+		}
+	}
+
+	if len(path) > len(r.segments) {
+		ids = append(ids, path[len(r.segments)])
+	}
+	return ids, true
+}
+
+// resolveResource finds the Resource whose endpoint most specifically matches
+// the request path, such as "instances" for "linode/instances/123/boot",
+// returning the IDs given in the path. An empty name is returned when no
+// Resource matches.
+func resolveResource(resources map[string]*Resource, path string) (name string, ids []string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	bestLiterals, bestLength := -1, -1
+	for _, r := range resources {
+		matchIDs, ok := r.matchPath(segments)
+		if !ok {
+			continue
+		}
+
+		literals := 0
+		for _, segment := range r.segments {
+			if len(segment) > 0 {
+				literals++
+			}
+		}
+
+		// Prefer the most literal segments, then the longest endpoint, then the name
+		if literals > bestLiterals ||
+			(literals == bestLiterals && len(r.segments) > bestLength) ||
+			(literals == bestLiterals && len(r.segments) == bestLength && r.name < name) {
+			name, ids = r.name, matchIDs
+			bestLiterals, bestLength = literals, len(r.segments)
+		}
+	}
+	return name, ids
 }
 
 func (r Resource) render(data ...interface{}) (string, error) {
@@ -161,6 +251,18 @@ func (r Resource) endpointWithID(id ...int) (string, error) {
 	return r.render(data...)
 }
 
+// endpointWithParams will return the rendered endpoint string for the resource with the provided
+// template parameters, which are required by templated resources and refused by others
+func (r Resource) endpointWithParams(params ...interface{}) (string, error) {
+	if !r.isTemplate {
+		if len(params) > 0 {
+			return "", NewError(fmt.Sprintf("Tried to get endpoint for %s with data for a template it does not have", r.name))
+		}
+		return r.endpoint, nil
+	}
+	return r.render(params...)
+}
+
 // Endpoint will return the non-templated endpoint string for resource
 func (r Resource) Endpoint() (string, error) {
 	if r.isTemplate {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -26,6 +27,20 @@ func TestResourceEndpoint(t *testing.T) {
 		t.Errorf("Images endpoint did not match '%s'", imagesEndpoint)
 	}
 }
+func TestResourceMissing(t *testing.T) {
+	client := NewClient(nil)
+
+	if r := client.Resource("does-not-exist"); r != nil {
+		t.Errorf("Expected no missing resource, got %v", r)
+	}
+	if _, err := client.LookupResource("does-not-exist"); err == nil {
+		t.Error("Expected an error when looking up a missing resource")
+	}
+	if r, err := client.LookupResource("images"); err != nil || r != client.Images {
+		t.Errorf("Expected the images resource, got %v and %v", r, err)
+	}
+}
+
 func TestResourceTemplatedendpointWithID(t *testing.T) {
 	apiKey := "MYFAKEAPIKEY"
 
@@ -44,13 +59,87 @@ func TestResourceTemplatedendpointWithID(t *testing.T) {
 }
 
 func TestUnmarshalTimeRemaining(t *testing.T) {
-	if *unmarshalTimeRemaining(json.RawMessage("\"1:23\"")) != 83 {
+	if v, err := unmarshalTimeRemaining(json.RawMessage("\"1:23\"")); err != nil || *v != 83 {
 		t.Errorf("Error parsing duration style time_remaining")
 	}
-	if unmarshalTimeRemaining(json.RawMessage("null")) != nil {
+	if v, err := unmarshalTimeRemaining(json.RawMessage("null")); err != nil || v != nil {
 		t.Errorf("Error parsing null time_remaining")
 	}
-	if *unmarshalTimeRemaining(json.RawMessage("0")) != 0 {
+	if v, err := unmarshalTimeRemaining(json.RawMessage("0")); err != nil || *v != 0 {
 		t.Errorf("Error parsing int style time_remaining")
 	}
+	if v, err := unmarshalTimeRemaining(json.RawMessage("\"soon\"")); err == nil || v != nil {
+		t.Errorf("Error rejecting malformed time_remaining")
+	}
+}
+
+func TestPagedResources(t *testing.T) {
+	client := NewClient(nil)
+
+	pagedTypes := map[reflect.Type]string{}
+	for name, r := range client.resources {
+		if r.pagedType == nil {
+			continue
+		}
+		if other, ok := pagedTypes[r.pagedType]; ok {
+			t.Errorf("%s is registered by both the %s and %s resources", r.pagedType.Name(), other, name)
+		}
+		pagedTypes[r.pagedType] = name
+
+		if !reflect.PtrTo(r.pagedType).Implements(reflect.TypeOf((*pagedResponse)(nil)).Elem()) {
+			t.Errorf("%s registered by the %s resource can not be listed", r.pagedType.Name(), name)
+		}
+	}
+
+	r, err := client.pagedResource(&NodeBalancersPagedResponse{})
+	if err != nil || r != client.NodeBalancers {
+		t.Errorf("Expected NodeBalancersPagedResponse to be listed by the nodebalancers resource, got %v, %v", r, err)
+	}
+
+	e, err := client.TaggedObjects.endpointWithParams("production")
+	if err != nil || e != "tags/production" {
+		t.Errorf("Expected the tagged objects endpoint to include the tag, got %q, %v", e, err)
+	}
+
+	if _, err := client.pagedResource(&unregisteredPagedResponse{}); err == nil {
+		t.Error("Expected an error listing an unregistered PagedResponse")
+	}
+}
+
+// unregisteredPagedResponse is a PagedResponse without a Resource
+type unregisteredPagedResponse struct {
+	*PageOptions
+	Data []struct{} `json:"data"`
+}
+
+func (resp *unregisteredPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*unregisteredPagedResponse).Data...)
+}
+
+func TestResolveResource(t *testing.T) {
+	client := NewClient(nil)
+
+	cases := map[string]struct {
+		name string
+		ids  []string
+	}{
+		"linode/instances":                       {instancesName, nil},
+		"linode/instances/123/boot":              {instancesName, []string{"123"}},
+		"linode/instances/123/configs/9":         {instanceConfigsName, []string{"123", "9"}},
+		"nodebalancers/1/configs/2/nodes/3":      {nodebalancernodesName, []string{"1", "2", "3"}},
+		"networking/ips/192.0.2.1":               {ipaddressesName, []string{"192.0.2.1"}},
+		"account/settings":                       {accountSettingsName, nil},
+		"tags/production":                        {taggedObjectsName, []string{"production"}},
+		"account/events/42/seen":                 {eventsName, []string{"42"}},
+		"unknown/endpoint":                       {"", nil},
+		"/linode/instances/123/disks/456/":       {instanceDisksName, []string{"123", "456"}},
+		"linode/instances/123/backups/7/restore": {instanceSnapshotsName, []string{"123", "7"}},
+	}
+
+	for path, expected := range cases {
+		name, ids := resolveResource(client.resources, path)
+		if name != expected.name || !reflect.DeepEqual(ids, expected.ids) {
+			t.Errorf("Expected %s to resolve to %s %v, got %s %v", path, expected.name, expected.ids, name, ids)
+		}
+	}
 }
@@ -0,0 +1,193 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the number of attempts (including the first) made by DefaultRetryPolicy
+	DefaultRetryMaxAttempts = 5
+	// DefaultRetryBaseDelay is the delay before the first retry made by DefaultRetryPolicy
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	// DefaultRetryMaxDelay is the longest backoff delay used by DefaultRetryPolicy
+	DefaultRetryMaxDelay = 30 * time.Second
+	// DefaultRetryJitter is the fraction of each backoff delay randomized by DefaultRetryPolicy
+	DefaultRetryJitter = 0.5
+)
+
+// RetryPolicy configures how a Client retries requests that failed with a
+// transient error. The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the backoff before the first retry. Each following retry doubles it.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the exponential backoff. Zero means no cap.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction (0.0 - 1.0) of each backoff delay that is randomized.
+	Jitter float64
+
+	// StatusCodes are the HTTP status codes that are retried.
+	StatusCodes []int
+
+	// RetryError reports whether an error returned without any HTTP response
+	// (a network error) should be retried. When nil, network errors are not retried.
+	RetryError func(err error) bool
+
+	// RespectRetryAfter waits for the duration given in the Retry-After header,
+	// when present, instead of the computed backoff. The wait is capped by MaxDelay.
+	RespectRetryAfter bool
+
+	// RetryNonIdempotent allows POST requests to be retried. The API may have
+	// acted on a POST which failed, so these are not retried unless requested.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for most clients. It retries
+// rate limited (429) and unavailable (5xx) responses as well as temporary network
+// errors, honoring the Retry-After header, but never retries POST requests.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+		Jitter:      DefaultRetryJitter,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryError:        IsTemporaryNetworkError,
+		RespectRetryAfter: true,
+	}
+}
+
+// IsTemporaryNetworkError reports whether err is a network error that is likely
+// to succeed when retried, such as a timeout or a reset connection.
+func IsTemporaryNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+// enabled reports whether the policy allows any retries
+func (p RetryPolicy) enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+// allowsMethod reports whether requests using the HTTP method may be retried
+func (p RetryPolicy) allowsMethod(method string) bool {
+	return method != http.MethodPost || p.RetryNonIdempotent
+}
+
+// retryable reports whether a request should be retried given the
+// outcome of its last attempt
+func (p RetryPolicy) retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return p.RetryError != nil && p.RetryError(err)
+	}
+
+	for _, code := range p.StatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the retry following the numbered
+// attempt (starting at 1)
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if p.RespectRetryAfter && resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxDelay > 0 && delay > p.MaxDelay {
+				delay = p.MaxDelay
+			}
+			return delay
+		}
+	}
+
+	delay := float64(p.BaseDelay) * math.Exp2(float64(attempt-1))
+	if p.MaxDelay > 0 {
+		delay = math.Min(delay, float64(p.MaxDelay))
+	}
+
+	if jitter := math.Max(0, math.Min(p.Jitter, 1)); jitter > 0 {
+		delay = delay*(1-jitter) + rand.Float64()*delay*jitter
+	}
+
+	return time.Duration(delay)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		delay := time.Until(when)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the delay to pass or the context to be done,
+// whichever comes first
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SetRetryPolicy sets the policy used to retry requests that failed with a
+// transient error. Use RetryPolicy{} to disable retries.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	c.transport.update(func(s *transportSettings) { s.retryPolicy = policy })
+	return c
+}
@@ -0,0 +1,176 @@
+package linodego_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+// testRetryPolicy is a RetryPolicy that retries quickly enough for tests
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+func TestListTypes_429Retry(t *testing.T) {
+	client, teardown := createTestClient(t, "fixtures/TestListTypes_429")
+	defer teardown()
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	client.SetRetryPolicy(policy)
+
+	_, err := client.ListTypes(context.Background(), nil)
+	if err == nil {
+		t.Errorf("Error listing types, expected error after retries were exhausted")
+	}
+}
+
+func TestRetryPolicy_retriesUntilSuccess(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Too Many Requests"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"page": 1, "pages": 1, "results": 1, "data": [{"id": "g6-nanode-1"}]}`))
+	}))
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	types, err := client.ListTypes(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Error listing types, expected success after retries, got %v", err)
+	}
+	if len(types) != 1 || types[0].ID != "g6-nanode-1" {
+		t.Errorf("Expected the type served after retries, got %v", types)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryPolicy_retryAfterCappedByMaxDelay(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Too Many Requests"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"page": 1, "pages": 1, "results": 0, "data": []}`))
+	}))
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.ListTypes(ctx, nil); err != nil {
+		t.Fatalf("Expected the Retry-After delay to be capped by MaxDelay, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestRetryPolicy_disabledByDefault(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Service Unavailable"}]}`))
+	}))
+	defer teardown()
+
+	if _, err := client.ListTypes(context.Background(), nil); err == nil {
+		t.Errorf("Error listing types, expected error")
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request without a RetryPolicy, got %d", requests)
+	}
+}
+
+func TestRetryPolicy_nonIdempotent(t *testing.T) {
+	var requests int32
+	var bodies []string
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Service Unavailable"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"label": "retried"}`))
+	}))
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	if _, err := client.CreateTag(context.Background(), TagCreateOptions{Label: "retried"}); err == nil {
+		t.Errorf("Error creating tag, expected POST not to be retried")
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 POST request, got %d", requests)
+	}
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	client.SetRetryPolicy(policy)
+	atomic.StoreInt32(&requests, 0)
+	bodies = nil
+
+	tag, err := client.CreateTag(context.Background(), TagCreateOptions{Label: "retried"})
+	if err != nil {
+		t.Fatalf("Error creating tag, expected POST to be retried, got %v", err)
+	}
+	if tag.Label != "retried" {
+		t.Errorf("Expected the tag served after retries, got %v", tag)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Fatalf("Expected the retried request to repeat the body, got %q", bodies)
+	}
+
+	var opts TagCreateOptions
+	if err := json.Unmarshal([]byte(bodies[1]), &opts); err != nil || opts.Label != "retried" {
+		t.Errorf("Expected the retried body to be valid, got %q", bodies[1])
+	}
+}
+
+func TestRetryPolicy_contextCanceled(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.ListTypes(ctx, nil); err == nil {
+		t.Errorf("Error listing types, expected the context deadline to end retries")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected Retry-After to be interrupted by the context, waited %v", elapsed)
+	}
+}
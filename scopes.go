@@ -0,0 +1,298 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ScopeLevel is the level of access a token scope grants to a kind of resource
+type ScopeLevel string
+
+// ScopeLevel constants are the levels of access, from least to most
+const (
+	ScopeReadOnly  ScopeLevel = "read_only"
+	ScopeReadWrite ScopeLevel = "read_write"
+)
+
+// ScopeAll is the scope granting read_write access to all resources
+const ScopeAll = "*"
+
+// scopeLevelRanks orders the ScopeLevels, including the levels of the legacy
+// "X-OAuth-Scopes" format, such as "linodes:delete"
+var scopeLevelRanks = map[ScopeLevel]int{
+	ScopeReadOnly:  1,
+	"view":         1,
+	ScopeReadWrite: 2,
+	"create":       2,
+	"modify":       2,
+	"delete":       2,
+}
+
+// ErrScopeDenied is matched by errors.Is for calls rejected by SetScopeCheck
+// because the token's scopes do not allow them
+var ErrScopeDenied = errors.New("token scope does not allow the request")
+
+// Scopes are the scopes of a token, the level of access granted to each kind of
+// resource, such as "linodes". The ScopeAll key grants access to all resources.
+type Scopes map[string]ScopeLevel
+
+// ParseScopes parses scopes such as "linodes:read_write domains:read_only" or
+// "*". Scopes may be separated by spaces or commas.
+func ParseScopes(s string) (Scopes, error) {
+	return parseScopes(s, true)
+}
+
+// parseScopes parses scopes, failing on unknown levels if strict. Otherwise
+// unknown levels are kept as they are, and allow no access.
+func parseScopes(s string, strict bool) (Scopes, error) {
+	scopes := Scopes{}
+	for _, scope := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if scope == ScopeAll {
+			scopes[ScopeAll] = ScopeReadWrite
+			continue
+		}
+
+		parts := strings.Split(scope, ":")
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("Invalid scope %q, expected a resource and level such as linodes:read_only", scope)
+		}
+		level := ScopeLevel(parts[1])
+		if _, ok := scopeLevelRanks[level]; !ok {
+			if strict {
+				return nil, fmt.Errorf("Invalid scope %q, unknown level %q", scope, level)
+			}
+			if _, granted := scopes[parts[0]]; !granted {
+				scopes[parts[0]] = level
+			}
+			continue
+		}
+		scopes.grant(parts[0], level)
+	}
+	return scopes, nil
+}
+
+// grant grants the level of access to the resource, unless more was granted already
+func (s Scopes) grant(resource string, level ScopeLevel) {
+	if scopeLevelRanks[level] == 1 {
+		level = ScopeReadOnly
+	} else {
+		level = ScopeReadWrite
+	}
+	if scopeLevelRanks[s[resource]] < scopeLevelRanks[level] {
+		s[resource] = level
+	}
+}
+
+// Allows reports whether the scopes grant the level of access to the resource
+func (s Scopes) Allows(resource string, level ScopeLevel) bool {
+	required := scopeLevelRanks[level]
+	return scopeLevelRanks[s[ScopeAll]] >= required || scopeLevelRanks[s[resource]] >= required
+}
+
+// String returns the scopes in the space-separated format of the API, sorted
+// by resource, or "*" when all resources are granted read_write access
+func (s Scopes) String() string {
+	if s[ScopeAll] == ScopeReadWrite {
+		return ScopeAll
+	}
+
+	resources := make([]string, 0, len(s))
+	for resource := range s {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	scopes := make([]string, len(resources))
+	for i, resource := range resources {
+		scopes[i] = resource + ":" + string(s[resource])
+	}
+	return strings.Join(scopes, " ")
+}
+
+// MarshalJSON encodes the scopes as a string
+func (s Scopes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes scopes from a string. Unlike ParseScopes, unknown
+// levels are kept, so that tokens with scopes newer than this package decode.
+func (s *Scopes) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	parsed, err := parseScopes(str, false)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// scopeResources are the token scope resources of each Resource. Resources
+// available to any token, such as regions and profile, are not included.
+var scopeResources = map[string]string{
+	accountName:               "account",
+	accountSettingsName:       "account",
+	invoicesName:              "account",
+	invoiceItemsName:          "account",
+	paymentsName:              "account",
+	usersName:                 "account",
+	oauthClientsName:          "account",
+	notificationsName:         "account",
+	domainsName:               "domains",
+	domainRecordsName:         "domains",
+	eventsName:                "events",
+	imagesName:                "images",
+	ipaddressesName:           "ips",
+	ipv6poolsName:             "ips",
+	ipv6rangesName:            "ips",
+	instancesName:             "linodes",
+	instanceDisksName:         "linodes",
+	instanceConfigsName:       "linodes",
+	instanceSnapshotsName:     "linodes",
+	instanceIPsName:           "linodes",
+	instanceVolumesName:       "linodes",
+	instanceStatsName:         "linodes",
+	longviewName:              "longview",
+	longviewclientsName:       "longview",
+	longviewsubscriptionsName: "longview",
+	nodebalancersName:         "nodebalancers",
+	nodebalancerconfigsName:   "nodebalancers",
+	nodebalancernodesName:     "nodebalancers",
+	stackscriptsName:          "stackscripts",
+	volumesName:               "volumes",
+}
+
+// scopeLevelOverrides are the levels required by the requests of a Resource
+// which are not read_only for GET and HEAD and read_write otherwise, by method
+// and last path segment
+var scopeLevelOverrides = map[string]map[string]ScopeLevel{
+	eventsName: {
+		http.MethodPost + " read": ScopeReadOnly,
+		http.MethodPost + " seen": ScopeReadOnly,
+	},
+}
+
+// requiredScopeLevel returns the level of access required by the request
+func requiredScopeLevel(info *RequestInfo) ScopeLevel {
+	segment := info.Path[strings.LastIndex(info.Path, "/")+1:]
+	if level, ok := scopeLevelOverrides[info.Resource][info.Method+" "+segment]; ok {
+		return level
+	}
+	if info.Method == http.MethodGet || info.Method == http.MethodHead {
+		return ScopeReadOnly
+	}
+	return ScopeReadWrite
+}
+
+// scopesHeader is the response header listing the scopes of the request's token
+const scopesHeader = "X-OAuth-Scopes"
+
+// scopeCheck holds the scopes of the Client's token, once they are looked up
+type scopeCheck struct {
+	lookup func(ctx context.Context) (Scopes, error)
+
+	// mu guards the fields below, but is not held during a lookup
+	mu     sync.Mutex
+	scopes Scopes
+	known  bool
+	// pending is closed when the lookup in progress, if any, ends
+	pending chan struct{}
+}
+
+// SetScopeCheck enables checking requests against the scopes of the Client's
+// token. The scopes are looked up with the first request needing them, and
+// requests the scopes do not allow fail with an error matching ErrScopeDenied,
+// without being sent. Disabled by default.
+func (c *Client) SetScopeCheck(enabled bool) *Client {
+	var check *scopeCheck
+	if enabled {
+		check = &scopeCheck{lookup: c.lookupScopes}
+	}
+	c.transport.update(func(s *transportSettings) { s.scopeCheck = check })
+	return c
+}
+
+// lookupScopes returns the scopes of the Client's token, as listed in the
+// headers of a response to a request any token may make
+func (c *Client) lookupScopes(ctx context.Context) (Scopes, error) {
+	e, err := c.Profile.Endpoint()
+	if err != nil {
+		return nil, err
+	}
+	r, err := coupleAPIErrors(c.request(ctx, "GetProfile").SetResult(&Profile{}).Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := r.Header()[http.CanonicalHeaderKey(scopesHeader)]
+	if !ok {
+		// without the header, nothing is known about the token
+		return nil, nil
+	}
+	return ParseScopes(strings.Join(header, ","))
+}
+
+// check returns an error when the token's scopes do not allow the request
+func (s *scopeCheck) check(ctx context.Context, info *RequestInfo) error {
+	resource, ok := scopeResources[info.Resource]
+	if !ok {
+		return nil
+	}
+	level := requiredScopeLevel(info)
+
+	scopes, err := s.tokenScopes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if scopes == nil || scopes.Allows(resource, level) {
+		return nil
+	}
+	return NewError(fmt.Errorf("%s %s requires the %s:%s scope, but the token has %q: %w",
+		info.Method, info.Path, resource, level, scopes, ErrScopeDenied))
+}
+
+// tokenScopes returns the scopes of the token, looking them up unless they are
+// known. Concurrent requests wait for a single lookup, and look the scopes up
+// again if it fails.
+func (s *scopeCheck) tokenScopes(ctx context.Context) (Scopes, error) {
+	for {
+		s.mu.Lock()
+		if s.known {
+			scopes := s.scopes
+			s.mu.Unlock()
+			return scopes, nil
+		}
+		if pending := s.pending; pending != nil {
+			s.mu.Unlock()
+			select {
+			case <-pending:
+				continue
+			case <-ctx.Done():
+				return nil, NewError(ctx.Err())
+			}
+		}
+		pending := make(chan struct{})
+		s.pending = pending
+		s.mu.Unlock()
+
+		scopes, err := s.lookup(ctx)
+
+		s.mu.Lock()
+		if err == nil {
+			s.scopes, s.known = scopes, true
+		}
+		s.pending = nil
+		close(pending)
+		s.mu.Unlock()
+		return scopes, err
+	}
+}
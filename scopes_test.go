@@ -0,0 +1,216 @@
+package linodego_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+func TestParseScopes(t *testing.T) {
+	for input, expected := range map[string]Scopes{
+		"*":                                    {ScopeAll: ScopeReadWrite},
+		"linodes:read_write domains:read_only": {"linodes": ScopeReadWrite, "domains": ScopeReadOnly},
+		"linodes:read_only,linodes:read_write": {"linodes": ScopeReadWrite},
+		"linodes:delete,domains:view":          {"linodes": ScopeReadWrite, "domains": ScopeReadOnly},
+		"":                                     {},
+	} {
+		scopes, err := ParseScopes(input)
+		if err != nil {
+			t.Errorf("Error parsing %q, got %v", input, err)
+		} else if !reflect.DeepEqual(scopes, expected) {
+			t.Errorf("Expected %q to parse as %v, got %v", input, expected, scopes)
+		}
+	}
+
+	for _, input := range []string{"linodes", "linodes:admin", ":read_only", "linodes:read_only:extra"} {
+		if _, err := ParseScopes(input); err == nil {
+			t.Errorf("Expected an error parsing %q", input)
+		}
+	}
+}
+
+func TestScopes_String(t *testing.T) {
+	scopes := Scopes{"linodes": ScopeReadWrite, "domains": ScopeReadOnly}
+	if s := scopes.String(); s != "domains:read_only linodes:read_write" {
+		t.Errorf("Expected scopes sorted by resource, got %q", s)
+	}
+	if s := (Scopes{ScopeAll: ScopeReadWrite, "linodes": ScopeReadOnly}).String(); s != "*" {
+		t.Errorf("Expected *, got %q", s)
+	}
+
+	body, err := json.Marshal(TokenCreateOptions{Label: "limited", Scopes: scopes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"scopes":"domains:read_only linodes:read_write"`) {
+		t.Errorf("Expected the scopes to be sent as a string, got %s", body)
+	}
+
+	var token Token
+	if err := json.Unmarshal([]byte(`{"id": 1, "scopes": "*"}`), &token); err != nil {
+		t.Fatal(err)
+	}
+	if !token.Scopes.Allows("linodes", ScopeReadWrite) {
+		t.Errorf("Expected * to be parsed, got %v", token.Scopes)
+	}
+
+	// unknown levels are kept when decoding, but allow no access
+	if err := json.Unmarshal([]byte(`{"id": 1, "scopes": "linodes:admin domains:read_only"}`), &token); err != nil {
+		t.Fatalf("Expected scopes with unknown levels to be decoded, got %v", err)
+	}
+	if token.Scopes["linodes"] != "admin" || token.Scopes.Allows("linodes", ScopeReadOnly) {
+		t.Errorf("Expected the unknown level to be kept without allowing access, got %v", token.Scopes)
+	}
+	if !token.Scopes.Allows("domains", ScopeReadOnly) {
+		t.Errorf("Expected the known levels to be parsed, got %v", token.Scopes)
+	}
+}
+
+func TestScopes_Allows(t *testing.T) {
+	scopes := Scopes{"linodes": ScopeReadWrite, "domains": ScopeReadOnly}
+
+	for _, test := range []struct {
+		resource string
+		level    ScopeLevel
+		allowed  bool
+	}{
+		{"linodes", ScopeReadOnly, true},
+		{"linodes", ScopeReadWrite, true},
+		{"domains", ScopeReadOnly, true},
+		{"domains", ScopeReadWrite, false},
+		{"volumes", ScopeReadOnly, false},
+	} {
+		if scopes.Allows(test.resource, test.level) != test.allowed {
+			t.Errorf("Expected Allows(%s, %s) to be %v", test.resource, test.level, test.allowed)
+		}
+	}
+
+	if !(Scopes{ScopeAll: ScopeReadWrite}).Allows("volumes", ScopeReadWrite) {
+		t.Error("Expected * to allow everything")
+	}
+}
+
+func TestSetScopeCheck(t *testing.T) {
+	var paths []string
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-OAuth-Scopes", "domains:read_only linodes:read_write")
+		_, _ = w.Write([]byte(`{"id": 123, "username": "someone"}`))
+	}))
+	defer teardown()
+
+	client.SetScopeCheck(true)
+
+	if _, err := client.GetDomain(context.Background(), 123); err != nil {
+		t.Errorf("Expected a read_only domains token to get domains, got %v", err)
+	}
+	if err := client.DeleteDomain(context.Background(), 123); !errors.Is(err, ErrScopeDenied) {
+		t.Errorf("Expected a read_only domains token not to delete domains, got %v", err)
+	}
+	if _, err := client.GetVolume(context.Background(), 123); !errors.Is(err, ErrScopeDenied) {
+		t.Errorf("Expected a token without volumes scopes not to get volumes, got %v", err)
+	}
+	if err := client.DeleteInstance(context.Background(), 123); err != nil {
+		t.Errorf("Expected a read_write linodes token to delete instances, got %v", err)
+	}
+
+	expected := []string{
+		"GET /profile",
+		"GET /domains/123",
+		"DELETE /linode/instances/123",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected the scopes to be looked up once and denied requests not sent, got %v", paths)
+	}
+}
+
+func TestSetScopeCheck_markEvents(t *testing.T) {
+	var paths []string
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-OAuth-Scopes", "events:read_only")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer teardown()
+
+	client.SetScopeCheck(true)
+
+	event := &Event{ID: 123}
+	if err := client.MarkEventsSeen(context.Background(), event); err != nil {
+		t.Errorf("Expected a read_only events token to mark events seen, got %v", err)
+	}
+	if err := client.MarkEventRead(context.Background(), event); err != nil {
+		t.Errorf("Expected a read_only events token to mark events read, got %v", err)
+	}
+
+	expected := []string{
+		"GET /profile",
+		"POST /account/events/123/seen",
+		"POST /account/events/123/read",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected the requests to be sent, got %v", paths)
+	}
+}
+
+func TestSetScopeCheck_concurrentLookup(t *testing.T) {
+	var lookups int32
+	release := make(chan struct{})
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/profile" {
+			atomic.AddInt32(&lookups, 1)
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-OAuth-Scopes", "domains:read_only")
+		_, _ = w.Write([]byte(`{"id": 123, "username": "someone"}`))
+	}))
+	defer teardown()
+
+	client.SetScopeCheck(true)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetDomain(context.Background(), 123)
+			errs <- err
+		}()
+	}
+
+	for atomic.LoadInt32(&lookups) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// requests waiting for the lookup still end with their context
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetDomain(ctx, 123); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected a request waiting for the lookup to time out, got %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Expected the requests to be allowed, got %v", err)
+		}
+	}
+	if atomic.LoadInt32(&lookups) != 1 {
+		t.Errorf("Expected the scopes to be looked up once, got %d lookups", lookups)
+	}
+}
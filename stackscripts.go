@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -94,24 +93,15 @@ type StackscriptsPagedResponse struct {
 	Data []Stackscript `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Stackscript
-func (StackscriptsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.StackScripts.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Stackscripts when processing paginated Stackscript responses
-func (resp *StackscriptsPagedResponse) appendData(r *StackscriptsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *StackscriptsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*StackscriptsPagedResponse).Data...)
 }
 
 // ListStackscripts lists Stackscripts
 func (c *Client) ListStackscripts(ctx context.Context, opts *ListOptions) ([]Stackscript, error) {
 	response := StackscriptsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListStackscripts", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -121,6 +111,13 @@ func (c *Client) ListStackscripts(ctx context.Context, opts *ListOptions) ([]Sta
 	return response.Data, nil
 }
 
+// ListStackscriptsFunc calls fn with each Stackscript, fetching pages as they are needed
+func (c *Client) ListStackscriptsFunc(ctx context.Context, opts *ListOptions, fn func(Stackscript) error) error {
+	return c.listFunc(ctx, "ListStackscriptsFunc", &StackscriptsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Stackscript).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *Stackscript) fixDates() *Stackscript {
 	i.Created, _ = parseDates(i.CreatedStr)
@@ -135,7 +132,7 @@ func (c *Client) GetStackscript(ctx context.Context, id int) (*Stackscript, erro
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetStackscript").
 		SetResult(&Stackscript{}).
 		Get(e))
 	if err != nil {
@@ -152,9 +149,9 @@ func (c *Client) CreateStackscript(ctx context.Context, createOpts StackscriptCr
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Stackscript{})
+	req := c.request(ctx, "CreateStackscript").SetResult(&Stackscript{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -179,9 +176,9 @@ func (c *Client) UpdateStackscript(ctx context.Context, id int, updateOpts Stack
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&Stackscript{})
+	req := c.request(ctx, "UpdateStackscript").SetResult(&Stackscript{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -205,6 +202,6 @@ func (c *Client) DeleteStackscript(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteStackscript").Delete(e))
 	return err
 }
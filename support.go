@@ -46,16 +46,8 @@ type TicketsPagedResponse struct {
 	Data []Ticket `json:"data"`
 }
 
-func (TicketsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Tickets.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
-func (resp *TicketsPagedResponse) appendData(r *TicketsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *TicketsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*TicketsPagedResponse).Data...)
 }
 
 // ListTickets returns a collection of Support Tickets on the Account. Support Tickets
@@ -64,13 +56,20 @@ func (resp *TicketsPagedResponse) appendData(r *TicketsPagedResponse) {
 // on the Account, with open tickets returned first.
 func (c *Client) ListTickets(ctx context.Context, opts *ListOptions) ([]Ticket, error) {
 	response := TicketsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListTickets", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListTicketsFunc calls fn with each Ticket, fetching pages as they are needed
+func (c *Client) ListTicketsFunc(ctx context.Context, opts *ListOptions, fn func(Ticket) error) error {
+	return c.listFunc(ctx, "ListTicketsFunc", &TicketsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Ticket))
+	})
+}
+
 // GetTicket gets a Support Ticket on the Account with the specified ID
 func (c *Client) GetTicket(ctx context.Context, id int) (*Ticket, error) {
 	e, err := c.Tickets.Endpoint()
@@ -78,7 +77,7 @@ func (c *Client) GetTicket(ctx context.Context, id int) (*Ticket, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).
+	r, err := coupleAPIErrors(c.request(ctx, "GetTicket").
 		SetResult(&Ticket{}).
 		Get(e))
 	if err != nil {
@@ -60,45 +60,33 @@ type TagsPagedResponse struct {
 	Data []Tag `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Tag
-func (TagsPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Tags.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
-// endpoint gets the endpoint URL for Tag
-func (TaggedObjectsPagedResponse) endpointWithID(c *Client, id string) string {
-	endpoint, err := c.Tags.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	endpoint = fmt.Sprintf("%s/%s", endpoint, id)
-	return endpoint
-}
-
 // appendData appends Tags when processing paginated Tag responses
-func (resp *TagsPagedResponse) appendData(r *TagsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *TagsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*TagsPagedResponse).Data...)
 }
 
 // appendData appends TaggedObjects when processing paginated TaggedObjects responses
-func (resp *TaggedObjectsPagedResponse) appendData(r *TaggedObjectsPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *TaggedObjectsPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*TaggedObjectsPagedResponse).Data...)
 }
 
 // ListTags lists Tags
 func (c *Client) ListTags(ctx context.Context, opts *ListOptions) ([]Tag, error) {
 	response := TagsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListTags", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListTagsFunc calls fn with each Tag, fetching pages as they are needed
+func (c *Client) ListTagsFunc(ctx context.Context, opts *ListOptions, fn func(Tag) error) error {
+	return c.listFunc(ctx, "ListTagsFunc", &TagsPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Tag))
+	})
+}
+
 // fixData stores an object of the type defined by Type in Data using RawData
 func (i *TaggedObject) fixData() (*TaggedObject, error) {
 	switch i.Type {
@@ -134,7 +122,7 @@ func (i *TaggedObject) fixData() (*TaggedObject, error) {
 // ListTaggedObjects lists Tagged Objects
 func (c *Client) ListTaggedObjects(ctx context.Context, label string, opts *ListOptions) (TaggedObjectList, error) {
 	response := TaggedObjectsPagedResponse{}
-	err := c.listHelperWithID(ctx, &response, label, opts)
+	err := c.listHelperWithID(ctx, "ListTaggedObjects", &response, label, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +134,17 @@ func (c *Client) ListTaggedObjects(ctx context.Context, label string, opts *List
 	return response.Data, nil
 }
 
+// ListTaggedObjectsFunc calls fn with each TaggedObject, fetching pages as they are needed
+func (c *Client) ListTaggedObjectsFunc(ctx context.Context, label string, opts *ListOptions, fn func(TaggedObject) error) error {
+	return c.listFunc(ctx, "ListTaggedObjectsFunc", &TaggedObjectsPagedResponse{}, opts, func(item interface{}) error {
+		object, err := item.(*TaggedObject).fixData()
+		if err != nil {
+			return err
+		}
+		return fn(*object)
+	}, label)
+}
+
 // SortedObjects converts a list of TaggedObjects into a Sorted Objects struct, for easier access
 func (t TaggedObjectList) SortedObjects() (SortedObjects, error) {
 	so := SortedObjects{}
@@ -188,9 +187,9 @@ func (c *Client) CreateTag(ctx context.Context, createOpts TagCreateOptions) (*T
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Tag{})
+	req := c.request(ctx, "CreateTag").SetResult(&Tag{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -214,6 +213,6 @@ func (c *Client) DeleteTag(ctx context.Context, label string) error {
 	}
 	e = fmt.Sprintf("%s/%s", e, label)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteTag").Delete(e))
 	return err
 }
@@ -9,7 +9,8 @@ package linodego
  - When updating Template structs,
    - use pointers where ever null'able would have a different meaning if the wrapper
 	 supplied "" or 0 instead
- - Add "NameOfResource" to client.go, resources.go, pagination.go
+ - Add "NameOfResource" to client.go and resources.go, registering
+   NameOfResourcesPagedResponse{} with its Resource so it can be listed
 */
 
 import (
@@ -53,24 +54,15 @@ type TemplatesPagedResponse struct {
 	Data []Template `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Template
-func (TemplatesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Templates.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Templates when processing paginated Template responses
-func (resp *TemplatesPagedResponse) appendData(r *TemplatesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *TemplatesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*TemplatesPagedResponse).Data...)
 }
 
 // ListTemplates lists Templates
 func (c *Client) ListTemplates(ctx context.Context, opts *ListOptions) ([]Template, error) {
 	response := TemplatesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListTemplates", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -80,6 +72,13 @@ func (c *Client) ListTemplates(ctx context.Context, opts *ListOptions) ([]Templa
 	return response.Data, nil
 }
 
+// ListTemplatesFunc calls fn with each Template, fetching pages as they are needed
+func (c *Client) ListTemplatesFunc(ctx context.Context, opts *ListOptions, fn func(Template) error) error {
+	return c.listFunc(ctx, "ListTemplatesFunc", &TemplatesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Template).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (i *Template) fixDates() *Template {
 	// i.Created, _ = parseDates(i.CreatedStr)
@@ -94,7 +93,7 @@ func (c *Client) GetTemplate(ctx context.Context, id int) (*Template, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Template{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetTemplate").SetResult(&Template{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -109,9 +108,9 @@ func (c *Client) CreateTemplate(ctx context.Context, createOpts TemplateCreateOp
 		return nil, err
 	}
 
-	req := c.R(ctx).SetResult(&Template{})
+	req := c.request(ctx, "CreateTemplate").SetResult(&Template{})
 
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -136,9 +135,9 @@ func (c *Client) UpdateTemplate(ctx context.Context, id int, updateOpts Template
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&Template{})
+	req := c.request(ctx, "UpdateTemplate").SetResult(&Template{})
 
-	if bodyData, err := json.Marshal(updateOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, updateOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -162,6 +161,6 @@ func (c *Client) DeleteTemplate(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteTemplate").Delete(e))
 	return err
 }
@@ -0,0 +1,168 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Tracer starts the spans describing the calls made with a Client. A span is
+// started for each call to a Client method making API requests, such as
+// "linodego.CreateInstance", and for each WaitFor function, with a child span
+// for each time it polls the API.
+type Tracer interface {
+	// Start starts a span named name, as a child of the span in ctx (if any),
+	// and returns a context carrying the new span
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// SetAttribute sets an attribute describing the span
+	SetAttribute(key string, value interface{})
+	// End ends the span, which failed if err is not nil
+	End(err error)
+}
+
+// Span attribute keys set by Client
+const (
+	SpanAttributeResource   = "linodego.resource"
+	SpanAttributeResourceID = "linodego.resource_id"
+	SpanAttributeIDs        = "linodego.ids"
+	SpanAttributeMethod     = "http.method"
+	SpanAttributeStatusCode = "http.status_code"
+	SpanAttributePoll       = "linodego.poll"
+)
+
+// SetTracer sets the Tracer starting the spans of the Client's calls. Use nil to stop tracing.
+func (c *Client) SetTracer(tracer Tracer) *Client {
+	c.transport.update(func(s *transportSettings) { s.tracer = tracer })
+	return c
+}
+
+type traceCallKey struct{}
+
+// traceSpan is a Span of a Client call, which is nil when the Client has no Tracer
+type traceSpan struct {
+	span Span
+}
+
+func (s *traceSpan) setAttribute(key string, value interface{}) {
+	if s != nil {
+		s.span.SetAttribute(key, value)
+	}
+}
+
+func (s *traceSpan) end(err error) {
+	if s == nil {
+		return
+	}
+	if e, ok := err.(*Error); ok && e == nil {
+		// avoid reporting a typed nil as an error
+		err = nil
+	}
+	s.span.End(err)
+}
+
+// startSpan starts the span of the named Client call, with the attributes given
+// as keys and values. The returned context identifies the call, so that the
+// requests it makes do not start their own spans.
+func (s transportSettings) startSpan(ctx context.Context, call string, attributes ...interface{}) (context.Context, *traceSpan) {
+	tracer := s.tracer
+	if tracer == nil {
+		return ctx, nil
+	}
+
+	ctx, span := tracer.Start(context.WithValue(ctx, traceCallKey{}, call), "linodego."+call)
+	for i := 0; i+1 < len(attributes); i += 2 {
+		if key, ok := attributes[i].(string); ok {
+			span.SetAttribute(key, attributes[i+1])
+		}
+	}
+	return ctx, &traceSpan{span}
+}
+
+// startSpan starts the span of a Client call, such as a WaitFor function
+func (c *Client) startSpan(ctx context.Context, call string, attributes ...interface{}) (context.Context, *traceSpan) {
+	return c.transport.current().startSpan(ctx, call, attributes...)
+}
+
+// startPollSpan starts the span of a poll made by a WaitFor function, counting the
+// polls made so far
+func (c *Client) startPollSpan(ctx context.Context, call string, polls *int) (context.Context, *traceSpan) {
+	*polls++
+	return c.transport.current().startSpan(ctx, call+".poll", SpanAttributePoll, *polls)
+}
+
+type operationKey struct{}
+
+// withOperation returns a context naming the Client method making the requests
+// with it, such as "CreateInstance"
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// startOperationSpan starts the span of the Client method named in ctx, unless the
+// span of that call was already started, such as by an earlier page of a List call
+func (s transportSettings) startOperationSpan(ctx context.Context, attributes ...interface{}) (context.Context, *traceSpan) {
+	if s.tracer == nil {
+		return ctx, nil
+	}
+
+	call, _ := ctx.Value(operationKey{}).(string)
+	active, _ := ctx.Value(traceCallKey{}).(string)
+	if len(call) == 0 || call == active {
+		return ctx, nil
+	}
+	return s.startSpan(ctx, call, attributes...)
+}
+
+// resourceAttributes returns the span attributes describing the requested resource
+func resourceAttributes(resource string, ids []string) []interface{} {
+	var attributes []interface{}
+	if len(resource) > 0 {
+		attributes = append(attributes, SpanAttributeResource, resource)
+	}
+	if len(ids) > 0 {
+		attributes = append(attributes,
+			SpanAttributeIDs, strings.Join(ids, ","),
+			SpanAttributeResourceID, ids[len(ids)-1])
+	}
+	return attributes
+}
+
+// startRequestSpan starts the span of the Client method making the request, if needed
+func (s transportSettings) startRequestSpan(ctx context.Context, info *RequestInfo) (context.Context, *traceSpan) {
+	if s.tracer == nil {
+		return ctx, nil
+	}
+	attributes := append([]interface{}{SpanAttributeMethod, info.Method}, resourceAttributes(info.Resource, info.IDs)...)
+	return s.startOperationSpan(ctx, attributes...)
+}
+
+// finishRequestSpan ends the span started for a request, if any
+func finishRequestSpan(span *traceSpan, statusCode int, body []byte, hasID bool, err *Error) {
+	if span == nil {
+		return
+	}
+
+	if statusCode > 0 {
+		span.setAttribute(SpanAttributeStatusCode, statusCode)
+	}
+
+	// The ID of a created resource is only known from the response
+	if !hasID && err == nil && len(body) > 0 {
+		var created struct {
+			ID interface{} `json:"id"`
+		}
+		if json.Unmarshal(body, &created) == nil && created.ID != nil {
+			if id, ok := created.ID.(float64); ok {
+				span.setAttribute(SpanAttributeResourceID, int(id))
+			} else {
+				span.setAttribute(SpanAttributeResourceID, created.ID)
+			}
+		}
+	}
+
+	span.end(err)
+}
@@ -0,0 +1,206 @@
+package linodego_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+func TestSetTracer_createInstance(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "traced", "status": "provisioning"}`))
+	}))
+	defer teardown()
+
+	recorder := &SpanRecorder{}
+	client.SetTracer(recorder)
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1"}); err != nil {
+		t.Fatalf("Error creating instance, got %v", err)
+	}
+
+	spans := recorder.Spans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %+v", spans)
+	}
+
+	span := spans[0]
+	if span.Name != "linodego.CreateInstance" || !span.Ended || span.Err != nil {
+		t.Errorf("Expected an ended linodego.CreateInstance span, got %+v", span)
+	}
+	if span.Attributes[SpanAttributeResourceID] != 123 {
+		t.Errorf("Expected the created instance's ID, got %v", span.Attributes[SpanAttributeResourceID])
+	}
+	if span.Attributes[SpanAttributeStatusCode] != http.StatusOK || span.Attributes[SpanAttributeResource] != "instances" {
+		t.Errorf("Unexpected attributes %v", span.Attributes)
+	}
+}
+
+func TestSetTracer_error(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+	}))
+	defer teardown()
+
+	recorder := &SpanRecorder{}
+	client.SetTracer(recorder)
+
+	if _, err := client.GetInstanceDisk(context.Background(), 123, 456); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+
+	spans := recorder.Spans()
+	if len(spans) != 1 || spans[0].Name != "linodego.GetInstanceDisk" {
+		t.Fatalf("Expected a linodego.GetInstanceDisk span, got %+v", spans)
+	}
+	if spans[0].Attributes[SpanAttributeResourceID] != "456" || spans[0].Attributes[SpanAttributeStatusCode] != http.StatusNotFound {
+		t.Errorf("Unexpected attributes %v", spans[0].Attributes)
+	}
+	if !IsNotFound(spans[0].Err) {
+		t.Errorf("Expected the span to end with the error, got %v", spans[0].Err)
+	}
+}
+
+func TestSetTracer_listPages(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, pagedEventsHandler(3, 2, &requests))
+	defer teardown()
+
+	recorder := &SpanRecorder{}
+	client.SetTracer(recorder)
+
+	if _, err := client.ListEvents(context.Background(), nil); err != nil {
+		t.Fatalf("Error listing events, got %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+
+	spans := recorder.Spans()
+	if len(spans) != 1 || spans[0].Name != "linodego.ListEvents" || !spans[0].Ended {
+		t.Errorf("Expected a single linodego.ListEvents span, got %+v", spans)
+	}
+}
+
+func TestSetTracer_waitFor(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := "booting"
+		if atomic.AddInt32(&requests, 1) >= 3 {
+			status = "running"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "status": "` + status + `"}`))
+	}))
+	defer teardown()
+
+	recorder := &SpanRecorder{}
+	client.SetTracer(recorder)
+
+	if _, err := client.WaitForInstanceStatus(context.Background(), 123, InstanceRunning, 5*time.Second); err != nil {
+		t.Fatalf("Error waiting for instance, got %v", err)
+	}
+
+	spans := recorder.Spans()
+	if len(spans) != 7 {
+		t.Fatalf("Expected a wait span with 3 polls and their requests, got %+v", spans)
+	}
+
+	wait := spans[0]
+	if wait.Name != "linodego.WaitForInstanceStatus" || wait.ParentID != 0 || !wait.Ended || wait.Err != nil {
+		t.Errorf("Expected an ended linodego.WaitForInstanceStatus root span, got %+v", wait)
+	}
+	for i := 1; i < len(spans); i += 2 {
+		poll, get := spans[i], spans[i+1]
+		if poll.Name != "linodego.WaitForInstanceStatus.poll" || poll.ParentID != wait.ID || poll.Attributes[SpanAttributePoll] != (i+1)/2 {
+			t.Errorf("Expected poll %d of the wait, got %+v", (i+1)/2, poll)
+		}
+		if get.Name != "linodego.GetInstance" || get.ParentID != poll.ID || get.Attributes[SpanAttributeResourceID] != "123" {
+			t.Errorf("Expected a linodego.GetInstance span within the poll, got %+v", get)
+		}
+	}
+}
+
+// RecordedSpan is a span recorded by a SpanRecorder
+type RecordedSpan struct {
+	ID         int
+	ParentID   int
+	Name       string
+	Attributes map[string]interface{}
+	Err        error
+	Start      time.Time
+	End        time.Time
+	Ended      bool
+}
+
+// SpanRecorder is a Tracer recording its spans in memory
+type SpanRecorder struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+var _ Tracer = (*SpanRecorder)(nil)
+
+type recordedSpanKey struct{}
+
+// Start implements Tracer
+func (r *SpanRecorder) Start(ctx context.Context, name string) (context.Context, Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	span := &RecordedSpan{
+		ID:         len(r.spans) + 1,
+		Name:       name,
+		Attributes: make(map[string]interface{}),
+		Start:      time.Now(),
+	}
+	if parent, ok := ctx.Value(recordedSpanKey{}).(int); ok {
+		span.ParentID = parent
+	}
+	r.spans = append(r.spans, span)
+
+	return context.WithValue(ctx, recordedSpanKey{}, span.ID), recorderSpan{r, span}
+}
+
+// Spans returns copies of the spans recorded so far, in the order they were started
+func (r *SpanRecorder) Spans() []RecordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := make([]RecordedSpan, len(r.spans))
+	for i, span := range r.spans {
+		spans[i] = *span
+		spans[i].Attributes = make(map[string]interface{}, len(span.Attributes))
+		for k, v := range span.Attributes {
+			spans[i].Attributes[k] = v
+		}
+	}
+	return spans
+}
+
+type recorderSpan struct {
+	recorder *SpanRecorder
+	span     *RecordedSpan
+}
+
+func (s recorderSpan) SetAttribute(key string, value interface{}) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.span.Attributes[key] = value
+}
+
+func (s recorderSpan) End(err error) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.span.Err = err
+	s.span.End = time.Now()
+	s.span.Ended = true
+}
@@ -0,0 +1,248 @@
+package linodego
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// apiTransport is the http.RoundTripper through which every Client request is
+// sent. It wraps the transport of the http.Client given to NewClient, adding
+// the behaviors configured on the Client, such as retries. It is shared by
+// copies of the Client, so its settings apply to all of them.
+type apiTransport struct {
+	// mu guards settings, which are replaced rather than modified, so that
+	// requests in flight keep the settings they started with
+	mu       sync.RWMutex
+	settings transportSettings
+}
+
+// transportSettings are the settings of an apiTransport
+type transportSettings struct {
+	base        http.RoundTripper
+	retryPolicy RetryPolicy
+	rateLimiter *rateLimiter
+	logger      Logger
+	debug       bool
+
+	beforeRequest []BeforeRequestFunc
+	afterResponse []AfterResponseFunc
+	metrics       MetricsCollector
+	tracer        Tracer
+	scopeCheck    *scopeCheck
+	dryRun        *dryRun
+	dryRunError   bool
+
+	// certErr is the error adding a root certificate, if any, which every
+	// request returns rather than trusting the system roots alone
+	certErr error
+}
+
+// newAPITransport wraps the base transport, which may be nil
+func newAPITransport(base http.RoundTripper) *apiTransport {
+	return &apiTransport{settings: transportSettings{base: base}}
+}
+
+// current returns the settings of the transport
+func (t *apiTransport) current() transportSettings {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.settings
+}
+
+// update changes the settings of the transport with fn
+func (t *apiTransport) update(fn func(s *transportSettings)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fn(&t.settings)
+}
+
+func (s transportSettings) baseTransport() http.RoundTripper {
+	if s.base == nil {
+		return http.DefaultTransport
+	}
+	return s.base
+}
+
+// addRootCertificate adds the PEM encoded certificates to the root certificates
+// trusted by a copy of the base transport, which must be an *http.Transport
+func (t *apiTransport) addRootCertificate(pem []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	base, ok := t.settings.baseTransport().(*http.Transport)
+	if !ok {
+		return errors.New("the transport of the http.Client is not an *http.Transport")
+	}
+	base = base.Clone()
+	if base.TLSClientConfig == nil {
+		base.TLSClientConfig = &tls.Config{}
+	}
+	if base.TLSClientConfig.RootCAs == nil {
+		base.TLSClientConfig.RootCAs = x509.NewCertPool()
+	}
+	if !base.TLSClientConfig.RootCAs.AppendCertsFromPEM(pem) {
+		return errors.New("no certificates found")
+	}
+	t.settings.base = base
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *apiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+	s := t.current()
+	if s.certErr != nil {
+		err = s.certErr
+	} else if d := s.dryRun; d != nil && isMutation(req.Method) {
+		resp, err = d.record(req, s.dryRunError)
+	} else {
+		resp, err = s.roundTrip(req)
+	}
+	if err != nil {
+		s.finishRequest(req.Context(), 0, nil, NewError(err))
+		return resp, err
+	}
+	// Responses which are followed by a redirect are finished by the response
+	// they lead to
+	if call := requestCallFromContext(req.Context()); call != nil && !isRedirect(resp) {
+		resp.Body = &finishingBody{ReadCloser: resp.Body, ctx: req.Context(), call: call, settings: s, resp: resp}
+	}
+	return resp, nil
+}
+
+// isRedirect reports whether the http.Client may follow the response to another request
+func isRedirect(resp *http.Response) bool {
+	return resp.StatusCode >= 300 && resp.StatusCode < 400 && len(resp.Header.Get("Location")) > 0
+}
+
+// finishingBody is the body of a response, finishing its requestCall once closed.
+// A response read and decoded by resty was already finished by afterResponseHook,
+// so the body only finishes the responses whose body could not be read or decoded.
+type finishingBody struct {
+	io.ReadCloser
+	ctx      context.Context
+	call     *requestCall
+	settings transportSettings
+	resp     *http.Response
+
+	readErr error
+}
+
+func (b *finishingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		b.readErr = err
+	}
+	return n, err
+}
+
+func (b *finishingBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.call.isFinished() {
+		b.settings.finishRequest(b.ctx, b.resp.StatusCode, nil, b.error())
+	}
+	return err
+}
+
+// error describes the failure to read or decode the response
+func (b *finishingBody) error() *Error {
+	message := fmt.Sprintf("Error decoding the response: %s", b.resp.Status)
+	if b.readErr != nil {
+		message = fmt.Sprintf("Error reading the response: %s", b.readErr)
+	}
+	return &Error{Code: b.resp.StatusCode, Message: message, Response: b.resp, err: b.readErr}
+}
+
+// roundTrip sends the request, retrying as allowed by the retry policy
+func (s transportSettings) roundTrip(req *http.Request) (*http.Response, error) {
+	policy := s.retryPolicy
+	if !policy.enabled() || !policy.allowsMethod(req.Method) {
+		return s.send(req)
+	}
+
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := s.send(req)
+
+		if attempt >= policy.MaxAttempts || !policy.retryable(resp, err) {
+			return resp, err
+		}
+
+		// The request body was consumed by the failed attempt and must be replaced
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = cloneRequestWithBody(req, body)
+		}
+
+		delay := policy.backoff(attempt, resp)
+		if s.metrics != nil {
+			s.metrics.RequestRetried(requestLabels(req))
+		}
+		if resp != nil {
+			s.getLogger().Debug("Retrying request", "method", req.Method, "path", req.URL.Path, "status", resp.StatusCode, "attempt", attempt, "delay", delay)
+			drainBody(resp.Body)
+		} else {
+			s.getLogger().Debug("Retrying request", "method", req.Method, "path", req.URL.Path, "error", err, "attempt", attempt, "delay", delay)
+		}
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+// send makes a single attempt at the request, within the rate limits
+func (s transportSettings) send(req *http.Request) (*http.Response, error) {
+	limiter := s.rateLimiter
+	if limiter == nil {
+		return s.baseTransport().RoundTrip(req)
+	}
+
+	waited, err := limiter.wait(req)
+	if waited > 0 {
+		s.getLogger().Debug("Waited for rate limit", "method", req.Method, "path", req.URL.Path, "delay", waited)
+		if s.metrics != nil {
+			s.metrics.RateLimitWaited(requestLabels(req), waited)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.baseTransport().RoundTrip(req)
+	if err == nil {
+		limiter.update(req, resp)
+	}
+	return resp, err
+}
+
+// cloneRequestWithBody returns a shallow copy of req using the new body
+func cloneRequestWithBody(req *http.Request, body io.ReadCloser) *http.Request {
+	clone := req.WithContext(req.Context())
+	clone.Body = body
+	return clone
+}
+
+// drainBody reads and closes a response body so its connection can be reused
+func drainBody(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	_ = body.Close()
+}
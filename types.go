@@ -52,28 +52,27 @@ type LinodeTypesPagedResponse struct {
 	Data []LinodeType `json:"data"`
 }
 
-func (LinodeTypesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Types.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
-func (resp *LinodeTypesPagedResponse) appendData(r *LinodeTypesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *LinodeTypesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*LinodeTypesPagedResponse).Data...)
 }
 
 // ListTypes lists linode types
 func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType, error) {
 	response := LinodeTypesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListTypes", &response, opts)
 	if err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
+// ListTypesFunc calls fn with each LinodeType, fetching pages as they are needed
+func (c *Client) ListTypesFunc(ctx context.Context, opts *ListOptions, fn func(LinodeType) error) error {
+	return c.listFunc(ctx, "ListTypesFunc", &LinodeTypesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*LinodeType))
+	})
+}
+
 // GetType gets the type with the provided ID
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e, err := c.Types.Endpoint()
@@ -82,7 +81,7 @@ func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error
 	}
 	e = fmt.Sprintf("%s/%s", e, typeID)
 
-	r, err := coupleAPIErrors(c.Types.R(ctx).Get(e))
+	r, err := coupleAPIErrors(c.Types.R(withOperation(ctx, "GetType")).Get(e))
 	if err != nil {
 		return nil, err
 	}
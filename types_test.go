@@ -23,6 +23,10 @@ func TestGetType_missing(t *testing.T) {
 	if e.Code != 404 {
 		t.Errorf("should have received a 404 Code requesting a missing image, got %v", e.Code)
 	}
+
+	if !linodego.IsNotFound(err) {
+		t.Errorf("should have matched ErrNotFound requesting a missing image, got %v", err)
+	}
 }
 
 func TestGetType_found(t *testing.T) {
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -92,24 +91,15 @@ func (v Volume) GetCreateOptions() (createOpts VolumeCreateOptions) {
 	return
 }
 
-// endpoint gets the endpoint URL for Volume
-func (VolumesPagedResponse) endpoint(c *Client) string {
-	endpoint, err := c.Volumes.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
 // appendData appends Volumes when processing paginated Volume responses
-func (resp *VolumesPagedResponse) appendData(r *VolumesPagedResponse) {
-	resp.Data = append(resp.Data, r.Data...)
+func (resp *VolumesPagedResponse) appendData(r pagedResponse) {
+	resp.Data = append(resp.Data, r.(*VolumesPagedResponse).Data...)
 }
 
 // ListVolumes lists Volumes
 func (c *Client) ListVolumes(ctx context.Context, opts *ListOptions) ([]Volume, error) {
 	response := VolumesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts)
+	err := c.listHelper(ctx, "ListVolumes", &response, opts)
 	for i := range response.Data {
 		response.Data[i].fixDates()
 	}
@@ -119,6 +109,13 @@ func (c *Client) ListVolumes(ctx context.Context, opts *ListOptions) ([]Volume,
 	return response.Data, nil
 }
 
+// ListVolumesFunc calls fn with each Volume, fetching pages as they are needed
+func (c *Client) ListVolumesFunc(ctx context.Context, opts *ListOptions, fn func(Volume) error) error {
+	return c.listFunc(ctx, "ListVolumesFunc", &VolumesPagedResponse{}, opts, func(item interface{}) error {
+		return fn(*item.(*Volume).fixDates())
+	})
+}
+
 // fixDates converts JSON timestamps to Go time.Time values
 func (v *Volume) fixDates() *Volume {
 	if parsed, err := parseDates(v.CreatedStr); err != nil {
@@ -137,7 +134,7 @@ func (c *Client) GetVolume(ctx context.Context, id int) (*Volume, error) {
 		return nil, err
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
-	r, err := coupleAPIErrors(c.R(ctx).SetResult(&Volume{}).Get(e))
+	r, err := coupleAPIErrors(c.request(ctx, "GetVolume").SetResult(&Volume{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +144,7 @@ func (c *Client) GetVolume(ctx context.Context, id int) (*Volume, error) {
 // AttachVolume attaches a volume to a Linode instance
 func (c *Client) AttachVolume(ctx context.Context, id int, options *VolumeAttachOptions) (*Volume, error) {
 	body := ""
-	if bodyData, err := json.Marshal(options); err == nil {
+	if bodyData, err := c.marshalBody(ctx, options); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -159,7 +156,7 @@ func (c *Client) AttachVolume(ctx context.Context, id int, options *VolumeAttach
 	}
 
 	e = fmt.Sprintf("%s/%d/attach", e, id)
-	resp, err := coupleAPIErrors(c.R(ctx).
+	resp, err := coupleAPIErrors(c.request(ctx, "AttachVolume").
 		SetResult(&Volume{}).
 		SetBody(body).
 		Post(e))
@@ -174,7 +171,7 @@ func (c *Client) AttachVolume(ctx context.Context, id int, options *VolumeAttach
 // CreateVolume creates a Linode Volume
 func (c *Client) CreateVolume(ctx context.Context, createOpts VolumeCreateOptions) (*Volume, error) {
 	body := ""
-	if bodyData, err := json.Marshal(createOpts); err == nil {
+	if bodyData, err := c.marshalBody(ctx, createOpts); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -185,7 +182,7 @@ func (c *Client) CreateVolume(ctx context.Context, createOpts VolumeCreateOption
 		return nil, NewError(err)
 	}
 
-	resp, err := coupleAPIErrors(c.R(ctx).
+	resp, err := coupleAPIErrors(c.request(ctx, "CreateVolume").
 		SetResult(&Volume{}).
 		SetBody(body).
 		Post(e))
@@ -213,9 +210,9 @@ func (c *Client) UpdateVolume(ctx context.Context, id int, volume VolumeUpdateOp
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	req := c.R(ctx).SetResult(&Volume{})
+	req := c.request(ctx, "UpdateVolume").SetResult(&Volume{})
 
-	if bodyData, err := json.Marshal(volume); err == nil {
+	if bodyData, err := c.marshalBody(ctx, volume); err == nil {
 		body = string(bodyData)
 	} else {
 		return nil, NewError(err)
@@ -241,7 +238,7 @@ func (c *Client) CloneVolume(ctx context.Context, id int, label string) (*Volume
 	}
 	e = fmt.Sprintf("%s/%d/clone", e, id)
 
-	resp, err := coupleAPIErrors(c.R(ctx).
+	resp, err := coupleAPIErrors(c.request(ctx, "CloneVolume").
 		SetResult(&Volume{}).
 		SetBody(body).
 		Post(e))
@@ -264,7 +261,7 @@ func (c *Client) DetachVolume(ctx context.Context, id int) error {
 
 	e = fmt.Sprintf("%s/%d/detach", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).
+	_, err = coupleAPIErrors(c.request(ctx, "DetachVolume").
 		SetBody(body).
 		Post(e))
 
@@ -281,7 +278,7 @@ func (c *Client) ResizeVolume(ctx context.Context, id int, size int) error {
 	}
 	e = fmt.Sprintf("%s/%d/resize", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).
+	_, err = coupleAPIErrors(c.request(ctx, "ResizeVolume").
 		SetBody(body).
 		Post(e))
 
@@ -296,6 +293,6 @@ func (c *Client) DeleteVolume(ctx context.Context, id int) error {
 	}
 	e = fmt.Sprintf("%s/%d", e, id)
 
-	_, err = coupleAPIErrors(c.R(ctx).Delete(e))
+	_, err = coupleAPIErrors(c.request(ctx, "DeleteVolume").Delete(e))
 	return err
 }
@@ -3,6 +3,7 @@ package linodego_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/linode/linodego"
 )
@@ -105,7 +106,7 @@ func TestWaitForVolumeLinodeID_nil(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error setting up volume test, %s", err)
 	}
-	_, err = client.WaitForVolumeLinodeID(context.Background(), volume.ID, nil, 3)
+	_, err = client.WaitForVolumeLinodeID(context.Background(), volume.ID, nil, 3*time.Second)
 
 	if err != nil {
 		t.Errorf("Error getting volume %d, expected *LinodeVolume, got error %v", volume.ID, err)
@@ -146,12 +147,12 @@ func TestWaitForVolumeLinodeID(t *testing.T) {
 		t.Errorf("Could not attach test volume to test instance")
 	}
 
-	_, errWait := client.WaitForVolumeLinodeID(context.Background(), volume.ID, nil, 3)
+	_, errWait := client.WaitForVolumeLinodeID(context.Background(), volume.ID, nil, 3*time.Second)
 	if errWait == nil {
 		t.Errorf("Expected to timeout waiting for nil LinodeID on volume %d : %s", volume.ID, errWait)
 	}
 
-	_, errWait = client.WaitForVolumeLinodeID(context.Background(), volume.ID, &instance.ID, 3)
+	_, errWait = client.WaitForVolumeLinodeID(context.Background(), volume.ID, &instance.ID, 3*time.Second)
 	if errWait != nil {
 		t.Errorf("Error waiting for volume %d to attach to instance %d: %s", volume.ID, instance.ID, errWait)
 	}
@@ -2,311 +2,560 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"strconv"
 	"strings"
 	"time"
+
+	"github.com/linode/linodego/filter"
 )
 
-// WaitForInstanceStatus waits for the Linode instance to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus, timeoutSeconds int) (*Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// PollFunc fetches the value polled by WaitFor
+type PollFunc func(ctx context.Context) (interface{}, error)
+
+// PollPredicate reports whether a value fetched by a PollFunc is the one waited
+// for. Returning an error ends the wait with that error.
+type PollPredicate func(value interface{}) (bool, error)
+
+// PollInfo describes a poll made by a WaitFor function, as given to its OnPoll callback
+type PollInfo struct {
+	// Attempt is the number of the poll, starting at 1
+	Attempt int
+	// Elapsed is the time since the wait started
+	Elapsed time.Duration
+	// Value is the value fetched by the poll, if any, such as an *Instance
+	Value interface{}
+	// Err is the error of the poll, if any
+	Err error
+	// Transient is set when Err is a transient error and the wait goes on
+	Transient bool
+	// Done is set when Value is the value waited for
+	Done bool
+	// Delay is the delay before the next poll, if there is one
+	Delay time.Duration
+}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			instance, err := client.GetInstance(ctx, instanceID)
-			if err != nil {
-				return instance, err
-			}
-			complete := (instance.Status == status)
+// PollOptions configure the polling of the WaitFor functions
+type PollOptions struct {
+	// Delay is the delay before the first poll. It defaults to the Client's
+	// poll delay (see SetPollDelay).
+	Delay time.Duration
 
-			if complete {
-				return instance, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d status %s: %s", instanceID, status, ctx.Err())
-		}
+	// Multiplier multiplies the delay after each poll. Values up to 1 keep
+	// the delay constant.
+	Multiplier float64
+
+	// MaxDelay caps the delay between polls. Zero means no cap.
+	MaxDelay time.Duration
+
+	// TransientErrors is the number of consecutive transient errors tolerated
+	// before the wait fails. None are tolerated by default.
+	TransientErrors int
+
+	// IsTransient reports whether a poll error is transient. It defaults to
+	// IsTransientError.
+	IsTransient func(err error) bool
+
+	// OnPoll is called after each poll
+	OnPoll func(info PollInfo)
+
+	// OnProgress is called whenever the progress of the event polled changes,
+	// by the WaitFor functions following events (see WithProgress)
+	OnProgress func(progress EventProgress)
+
+	// AdaptiveMaxDelay, when set, replaces the delay between polls with half
+	// of the time remaining of the event polled, if known, bounded by Delay and
+	// AdaptiveMaxDelay (see WithAdaptiveDelay)
+	AdaptiveMaxDelay time.Duration
+}
+
+// DefaultAdaptiveMaxDelay is the longest delay between the polls of WaitForEventFinished,
+// which adapts its delays to the time remaining of the event by default
+const DefaultAdaptiveMaxDelay = 2 * time.Minute
+
+// EventProgress is the progress of an Event, as given to the callback set by WithProgress
+type EventProgress struct {
+	// EventID is the ID of the Event
+	EventID int
+	// Action is the action of the Event
+	Action EventAction
+	// Status is the current status of the Event
+	Status EventStatus
+	// PercentComplete estimates the progress of the Event
+	PercentComplete int
+	// Rate is the rate of completion of the Event, for Events such as migrations
+	// and resizes, or ""
+	Rate string
+	// TimeRemaining estimates the time until the Event completes, or is zero if
+	// it is not known
+	TimeRemaining time.Duration
+	// Elapsed is the time since the wait started
+	Elapsed time.Duration
+}
+
+// newEventProgress returns the progress of the event
+func newEventProgress(event *Event, elapsed time.Duration) EventProgress {
+	progress := EventProgress{
+		EventID:         event.ID,
+		Action:          event.Action,
+		Status:          event.Status,
+		PercentComplete: event.PercentComplete,
+		Elapsed:         elapsed,
 	}
+	if event.Rate != nil {
+		progress.Rate = *event.Rate
+	}
+	if event.TimeRemaining != nil {
+		progress.TimeRemaining = time.Duration(*event.TimeRemaining) * time.Second
+	}
+	return progress
 }
 
-// WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// changed reports whether the progress differs from the earlier progress, apart
+// from the time elapsed
+func (p EventProgress) changed(earlier EventProgress) bool {
+	p.Elapsed = earlier.Elapsed
+	return p != earlier
+}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			// GetInstanceDisk will 404 on newly created disks. use List instead.
-			// disk, err := client.GetInstanceDisk(ctx, instanceID, diskID)
-			disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
-			if err != nil {
-				return nil, err
-			}
-			for _, disk := range disks {
-				disk := disk
-				if disk.ID == diskID {
-					complete := (disk.Status == status)
-					if complete {
-						return &disk, nil
-					}
-					break
-				}
-			}
+// PollOption changes the PollOptions of a WaitFor call
+type PollOption func(o *PollOptions)
 
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %s", instanceID, diskID, status, ctx.Err())
-		}
+// WithBackoff multiplies the delay between polls by multiplier after each poll,
+// up to maxDelay
+func WithBackoff(multiplier float64, maxDelay time.Duration) PollOption {
+	return func(o *PollOptions) {
+		o.Multiplier = multiplier
+		o.MaxDelay = maxDelay
 	}
 }
 
-// WaitForVolumeStatus waits for the Volume to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus, timeoutSeconds int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// WithTransientErrors tolerates up to n consecutive transient poll errors, such
+// as a 404 for a disk the API has only just created
+func WithTransientErrors(n int) PollOption {
+	return func(o *PollOptions) { o.TransientErrors = n }
+}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, err
-			}
-			complete := (volume.Status == status)
+// WithOnPoll calls fn after each poll
+func WithOnPoll(fn func(info PollInfo)) PollOption {
+	return func(o *PollOptions) { o.OnPoll = fn }
+}
 
-			if complete {
-				return volume, nil
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d status %s: %s", volumeID, status, ctx.Err())
-		}
+// WithProgress calls fn with the progress of the event polled whenever it changes.
+// WaitForEventFinished reports the progress of its event, while WaitForInstanceStatus
+// and WaitForInstanceDiskStatus report the progress of the latest event of the
+// Instance in progress, such as a migration or a resize.
+func WithProgress(fn func(progress EventProgress)) PollOption {
+	return func(o *PollOptions) { o.OnProgress = fn }
+}
+
+// WithAdaptiveDelay waits about half of the time remaining of the event polled
+// between polls, when it is known, bounded by the poll delay and maxDelay. Long
+// migrations and resizes are then polled less often. WaitForEventFinished adapts
+// its delays up to DefaultAdaptiveMaxDelay by default. Zero disables it.
+func WithAdaptiveDelay(maxDelay time.Duration) PollOption {
+	return func(o *PollOptions) { o.AdaptiveMaxDelay = maxDelay }
+}
+
+// IsTransientError reports whether a poll failing with err may succeed when
+// repeated: a 404 for a resource the API has only just created, a rate limited
+// or 5xx response, or a temporary network error
+func IsTransientError(err error) bool {
+	return errors.Is(err, ErrNotFound) || errors.Is(err, ErrRateLimited) ||
+		errors.Is(err, ErrServerError) || IsTemporaryNetworkError(err)
+}
+
+// nextDelay returns the delay following delay
+func (o PollOptions) nextDelay(delay time.Duration) time.Duration {
+	if o.Multiplier > 1 {
+		delay = time.Duration(float64(delay) * o.Multiplier)
 	}
+	if o.MaxDelay > 0 && delay > o.MaxDelay {
+		delay = o.MaxDelay
+	}
+	return delay
 }
 
-// WaitForSnapshotStatus waits for the Snapshot to reach the desired state
-// before returning. It will timeout with an error after timeoutSeconds.
-func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int, snapshotID int, status InstanceSnapshotStatus, timeoutSeconds int) (*InstanceSnapshot, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// adaptiveDelay returns the delay adapted to the time remaining of the event, or
+// zero if it does not apply
+func (o PollOptions) adaptiveDelay(event *Event) time.Duration {
+	if o.AdaptiveMaxDelay <= 0 || event.TimeRemaining == nil {
+		return 0
+	}
+	delay := time.Duration(*event.TimeRemaining) * time.Second / 2
+	if delay < o.Delay {
+		delay = o.Delay
+	}
+	if delay > o.AdaptiveMaxDelay {
+		delay = o.AdaptiveMaxDelay
+	}
+	return delay
+}
+
+// wait describes a WaitFor call
+type wait struct {
+	// call names the WaitFor function in its span
+	call string
+	// description describes what is waited for in errors
+	description string
+	// attributes are the span attributes of the call
+	attributes []interface{}
+	timeout    time.Duration
+
+	// progress, if set, returns the event in progress for the value fetched,
+	// if any. It is only called for the progress callback or adaptive delays.
+	progress func(ctx context.Context, value interface{}) *Event
+}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
-	defer ticker.Stop()
+// WaitFor polls fetch until done reports that the fetched value is the one waited
+// for, then returns that value. It will timeout with an error after timeout, unless
+// timeout is zero.
+func (client *Client) WaitFor(ctx context.Context, timeout time.Duration, fetch PollFunc, done PollPredicate, opts ...PollOption) (interface{}, error) {
+	return client.waitFor(ctx, wait{call: "WaitFor", description: "condition", timeout: timeout}, fetch, done, opts)
+}
+
+// waitFor implements the WaitFor functions
+func (client *Client) waitFor(ctx context.Context, w wait, fetch PollFunc, done PollPredicate, opts []PollOption) (_ interface{}, err error) {
+	ctx, span := client.startSpan(ctx, w.call, w.attributes...)
+	defer func() { span.end(err) }()
+	polls := 0
+
+	o := PollOptions{Delay: client.pollDelay(), IsTransient: IsTransientError}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if w.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+
+	followProgress := w.progress != nil && (o.OnProgress != nil || o.AdaptiveMaxDelay > 0)
+	var lastProgress *EventProgress
+
+	start := time.Now()
+	delay := o.Delay
+	transientErrors := 0
 	for {
-		select {
-		case <-ticker.C:
-			snapshot, err := client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
-			if err != nil {
-				return snapshot, err
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, fmt.Errorf("Error waiting for %s: %w", w.description, err)
+		}
+
+		pollCtx, poll := client.startPollSpan(ctx, w.call, &polls)
+		value, err := fetch(pollCtx)
+		var event *Event
+		if err == nil && followProgress {
+			event = w.progress(pollCtx, value)
+		}
+		poll.end(err)
+
+		if event != nil && o.OnProgress != nil {
+			progress := newEventProgress(event, time.Since(start))
+			if lastProgress == nil || progress.changed(*lastProgress) {
+				o.OnProgress(progress)
+				lastProgress = &progress
 			}
-			complete := (snapshot.Status == status)
+		}
 
-			if complete {
-				return snapshot, nil
+		info := PollInfo{Attempt: polls, Value: value, Err: err}
+		switch {
+		case err == nil:
+			transientErrors = 0
+			info.Done, err = done(value)
+		case transientErrors < o.TransientErrors && o.IsTransient(err):
+			transientErrors++
+			info.Transient = true
+		}
+		if !info.Done && (err == nil || info.Transient) {
+			delay = o.nextDelay(delay)
+			if event != nil {
+				if adaptive := o.adaptiveDelay(event); adaptive > 0 {
+					delay = adaptive
+				}
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %s", instanceID, snapshotID, status, ctx.Err())
+			info.Delay = delay
+		}
+		info.Elapsed = time.Since(start)
+		if o.OnPoll != nil {
+			o.OnPoll(info)
+		}
+
+		switch {
+		case info.Transient:
+			client.logger().Debug("Polling again after a transient error", "call", w.call, "error", err, "attempt", polls)
+		case err != nil && ctx.Err() != nil:
+			// the poll was interrupted by the timeout
+			return value, fmt.Errorf("Error waiting for %s: %w", w.description, ctx.Err())
+		case err != nil:
+			return value, err
+		case info.Done:
+			return value, nil
 		}
 	}
 }
 
-// WaitForVolumeLinodeID waits for the Volume to match the desired LinodeID
-// before returning. An active Instance will not immediately attach or detach a volume, so the
-// the LinodeID must be polled to determine volume readiness from the API.
-// WaitForVolumeLinodeID will timeout with an error after timeoutSeconds.
-func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, linodeID *int, timeoutSeconds int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// WaitForInstanceStatus waits for the Linode instance to reach the desired state
+// before returning. It will timeout with an error after timeout.
+func (client *Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus, timeout time.Duration, opts ...PollOption) (*Instance, error) {
+	value, err := client.waitFor(ctx, wait{
+		call:        "WaitForInstanceStatus",
+		description: fmt.Sprintf("Instance %d status %s", instanceID, status),
+		attributes:  []interface{}{SpanAttributeResourceID, instanceID},
+		timeout:     timeout,
+		progress: func(ctx context.Context, _ interface{}) *Event {
+			return client.instanceEventInProgress(ctx, instanceID)
+		},
+	}, func(ctx context.Context) (interface{}, error) {
+		return client.GetInstance(ctx, instanceID)
+	}, func(value interface{}) (bool, error) {
+		return value.(*Instance).Status == status, nil
+	}, opts)
+
+	instance, _ := value.(*Instance)
+	return instance, err
+}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, err
+// WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
+// before returning. It will timeout with an error after timeout.
+func (client *Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeout time.Duration, opts ...PollOption) (*InstanceDisk, error) {
+	value, err := client.waitFor(ctx, wait{
+		call:        "WaitForInstanceDiskStatus",
+		description: fmt.Sprintf("Instance %d Disk %d status %s", instanceID, diskID, status),
+		attributes:  []interface{}{SpanAttributeResourceID, diskID},
+		timeout:     timeout,
+		progress: func(ctx context.Context, _ interface{}) *Event {
+			return client.instanceEventInProgress(ctx, instanceID)
+		},
+	}, func(ctx context.Context) (interface{}, error) {
+		// GetInstanceDisk will 404 on newly created disks. use List instead.
+		disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i := range disks {
+			if disks[i].ID == diskID {
+				return &disks[i], nil
 			}
+		}
+		return nil, nil
+	}, func(value interface{}) (bool, error) {
+		disk, ok := value.(*InstanceDisk)
+		return ok && disk.Status == status, nil
+	}, opts)
+
+	disk, _ := value.(*InstanceDisk)
+	return disk, err
+}
 
-			switch {
-			case linodeID == nil && volume.LinodeID == nil:
-				return volume, nil
-			case linodeID == nil || volume.LinodeID == nil:
-				// continue waiting
-			case *volume.LinodeID == *linodeID:
-				return volume, nil
-			}
+// instanceEventInProgress returns the latest event of the Instance in progress,
+// if any. Events are only looked up to follow the progress of a wait, so errors
+// are logged rather than failing the wait.
+func (client *Client) instanceEventInProgress(ctx context.Context, instanceID int) *Event {
+	xFilter, err := filter.And(
+		filter.Eq("entity.id", instanceID),
+		filter.Eq("entity.type", EntityLinode),
+	).OrderBy("created", filter.Desc).Build()
+	if err != nil {
+		return nil
+	}
 
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d to have Instance %v: %s", volumeID, linodeID, ctx.Err())
+	events, err := client.ListEvents(ctx, NewListOptions(1, xFilter))
+	if err != nil {
+		client.logger().Debug("Error looking up the progress of the Instance", "instance_id", instanceID, "error", err)
+		return nil
+	}
+	for i := range events {
+		if events[i].Status == EventStarted || events[i].Status == EventScheduled {
+			return &events[i]
 		}
 	}
+	return nil
+}
+
+// WaitForVolumeStatus waits for the Volume to reach the desired state
+// before returning. It will timeout with an error after timeout.
+func (client *Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus, timeout time.Duration, opts ...PollOption) (*Volume, error) {
+	value, err := client.waitFor(ctx, wait{
+		call:        "WaitForVolumeStatus",
+		description: fmt.Sprintf("Volume %d status %s", volumeID, status),
+		attributes:  []interface{}{SpanAttributeResourceID, volumeID},
+		timeout:     timeout,
+	}, func(ctx context.Context) (interface{}, error) {
+		return client.GetVolume(ctx, volumeID)
+	}, func(value interface{}) (bool, error) {
+		return value.(*Volume).Status == status, nil
+	}, opts)
+
+	volume, _ := value.(*Volume)
+	return volume, err
+}
+
+// WaitForSnapshotStatus waits for the Snapshot to reach the desired state
+// before returning. It will timeout with an error after timeout.
+func (client *Client) WaitForSnapshotStatus(ctx context.Context, instanceID int, snapshotID int, status InstanceSnapshotStatus, timeout time.Duration, opts ...PollOption) (*InstanceSnapshot, error) {
+	value, err := client.waitFor(ctx, wait{
+		call:        "WaitForSnapshotStatus",
+		description: fmt.Sprintf("Instance %d Snapshot %d status %s", instanceID, snapshotID, status),
+		attributes:  []interface{}{SpanAttributeResourceID, snapshotID},
+		timeout:     timeout,
+	}, func(ctx context.Context) (interface{}, error) {
+		return client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
+	}, func(value interface{}) (bool, error) {
+		return value.(*InstanceSnapshot).Status == status, nil
+	}, opts)
+
+	snapshot, _ := value.(*InstanceSnapshot)
+	return snapshot, err
+}
+
+// WaitForVolumeLinodeID waits for the Volume to match the desired LinodeID
+// before returning. An active Instance will not immediately attach or detach a volume, so the
+// the LinodeID must be polled to determine volume readiness from the API.
+// WaitForVolumeLinodeID will timeout with an error after timeout.
+func (client *Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, linodeID *int, timeout time.Duration, opts ...PollOption) (*Volume, error) {
+	value, err := client.waitFor(ctx, wait{
+		call:        "WaitForVolumeLinodeID",
+		description: fmt.Sprintf("Volume %d to have Instance %v", volumeID, linodeID),
+		attributes:  []interface{}{SpanAttributeResourceID, volumeID},
+		timeout:     timeout,
+	}, func(ctx context.Context) (interface{}, error) {
+		return client.GetVolume(ctx, volumeID)
+	}, func(value interface{}) (bool, error) {
+		volume := value.(*Volume)
+		switch {
+		case linodeID == nil && volume.LinodeID == nil:
+			return true, nil
+		case linodeID == nil || volume.LinodeID == nil:
+			// continue waiting
+			return false, nil
+		default:
+			return *volume.LinodeID == *linodeID, nil
+		}
+	}, opts)
+
+	volume, _ := value.(*Volume)
+	return volume, err
 }
 
 // WaitForEventFinished waits for an entity action to reach the 'finished' state
-// before returning. It will timeout with an error after timeoutSeconds.
+// before returning. It will timeout with an error after timeout.
 // If the event indicates a failure both the failed event and the error will be returned.
-func (client Client) WaitForEventFinished(ctx context.Context, id interface{}, entityType EntityType, action EventAction, minStart time.Time, timeoutSeconds int) (*Event, error) {
+// The delay between polls adapts to the time remaining of the event (see WithAdaptiveDelay).
+//
+// The event waited for is the earliest event of the action on the entity created
+// at or after minStart. Events are listed from the latest, as many pages as needed,
+// and each is inspected only once, so the wait is not affected by events being marked
+// as seen or by the number of events.
+func (client *Client) WaitForEventFinished(ctx context.Context, id interface{}, entityType EntityType, action EventAction, minStart time.Time, timeout time.Duration, opts ...PollOption) (*Event, error) {
+	opts = append([]PollOption{WithAdaptiveDelay(DefaultAdaptiveMaxDelay)}, opts...)
 	titledEntityType := strings.Title(string(entityType))
-	filterStruct := map[string]interface{}{
-		// Nor is action
-		//"action": action,
-
-		// Created is not correctly filtered by the API
-		// We'll have to verify these values manually, for now.
-		//"created": map[string]interface{}{
-		//	"+gte": minStart.Format(time.RFC3339),
-		//},
-
-		// With potentially 1000+ events coming back, we should filter on something
-		// Warning: This optimization has the potential to break if users are clearing
-		// events before we see them.
-		"seen": false,
-
-		// Float the latest events to page 1
-		"+order_by": "created",
-		"+order":    "desc",
-	}
-
-	// Optimistically restrict results to page 1.  We should remove this when more
-	// precise filtering options exist.
-	pages := 1
 
 	// The API has limitted filtering support for Event ID and Event Type
 	// Optimize the list, if possible
-	switch entityType {
-	case EntityDisk, EntityLinode, EntityDomain, EntityNodebalancer:
-		// All of the filter supported types have int ids
-		filterableEntityID, err := strconv.Atoi(fmt.Sprintf("%v", id))
-		if err != nil {
-			return nil, fmt.Errorf("Error parsing Entity ID %q for optimized WaitForEventFinished EventType %q: %s", id, entityType, err)
-		}
-		filterStruct["entity.id"] = filterableEntityID
-		filterStruct["entity.type"] = entityType
-
-		// TODO: are we conformatable with pages = 0 with the event type and id filter?
+	var conditions []*filter.Filter
+	idFilter, err := entityIDFilter(entityType, id)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Entity ID %q for optimized WaitForEventFinished EventType %q: %s", id, entityType, err)
+	}
+	if idFilter != nil {
+		conditions = append(conditions, idFilter, filter.Eq("entity.type", entityType))
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
-
-	if deadline, ok := ctx.Deadline(); ok {
-		duration := time.Until(deadline)
-		log.Printf("[INFO] Waiting %d seconds for %s events since %v for %s %v", int(duration.Seconds()), action, minStart, titledEntityType, id)
+	logger := client.logger()
+	if timeout > 0 {
+		logger.Info("Waiting for event", "entity_type", entityType, "entity_id", id, "action", action,
+			"since", minStart, "timeout", timeout)
 	}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	matches := func(event Event) bool {
+		if event.Action != action || event.Entity == nil || event.Entity.Type != entityType {
+			return false
+		}
+		return formatEntityID(event.Entity.ID) == formatEntityID(id)
+	}
 
 	// avoid repeating log messages
-	nextLog := ""
-	lastLog := ""
-	lastEventID := 0
+	var lastStatus EventStatus
+
+	// cursor is the ID of the latest event inspected. Whether an event matches
+	// does not change, so only later events are listed by the following polls.
+	cursor := 0
+	foundID := 0
+
+	value, err := client.waitFor(ctx, wait{
+		call:        "WaitForEventFinished",
+		description: fmt.Sprintf("Event Status '%s' of %s %v action '%s'", EventFinished, titledEntityType, id, action),
+		attributes:  []interface{}{SpanAttributeResourceID, id},
+		timeout:     timeout,
+		progress: func(_ context.Context, value interface{}) *Event {
+			event, _ := value.(*Event)
+			return event
+		},
+	}, func(ctx context.Context) (interface{}, error) {
+		if foundID > 0 {
+			return client.GetEvent(ctx, foundID)
+		}
 
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			if lastEventID > 0 {
-				filterStruct["id"] = map[string]interface{}{
-					"+gte": lastEventID,
-				}
-			}
+		pollConditions := append([]*filter.Filter{filter.Gt("id", cursor)}, conditions...)
+		xFilter, err := filter.And(pollConditions...).OrderBy("created", filter.Desc).Build()
+		if err != nil {
+			return nil, err
+		}
 
-			filter, err := json.Marshal(filterStruct)
-			if err != nil {
-				return nil, err
+		var found *Event
+		latest := cursor
+		err = client.ListEventsFunc(ctx, &ListOptions{Filter: xFilter}, func(event Event) error {
+			if event.ID > latest {
+				latest = event.ID
 			}
-			listOptions := NewListOptions(pages, string(filter))
-
-			events, err := client.ListEvents(ctx, listOptions)
-			if err != nil {
-				return nil, err
+			if event.Created == nil {
+				logger.Warn("event.Created is nil when API returned", "created", event.CreatedStr, "event_id", event.ID)
+			} else if event.Created.Before(minStart) {
+				// The following events are older still
+				return ErrStopIteration
 			}
-
-			// If there are events for this instance + action, inspect them
-			for _, event := range events {
+			if matches(event) {
+				// Keep the earliest event of the action
 				event := event
+				found = &event
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
 
-				if event.Action != action {
-					// log.Println("action mismatch", event.Action, action)
-					continue
-				}
-				if event.Entity == nil || event.Entity.Type != entityType {
-					// log.Println("type mismatch", event.Entity.Type, entityType)
-					continue
-				}
-
-				var entID string
-
-				switch id := event.Entity.ID.(type) {
-				case float64, float32:
-					entID = fmt.Sprintf("%.f", id)
-				case int:
-					entID = strconv.Itoa(id)
-				default:
-					entID = fmt.Sprintf("%v", id)
-				}
-
-				var findID string
-				switch id := id.(type) {
-				case float64, float32:
-					findID = fmt.Sprintf("%.f", id)
-				case int:
-					findID = strconv.Itoa(id)
-				default:
-					findID = fmt.Sprintf("%v", id)
-				}
-
-				if entID != findID {
-					// log.Println("id mismatch", entID, findID)
-					continue
-				}
-
-				// @TODO(displague) This event.Created check shouldn't be needed, but it appears
-				// that the ListEvents method is not populating it correctly
-				if event.Created == nil {
-					log.Printf("[WARN] event.Created is nil when API returned: %#+v", event.CreatedStr)
-				} else if *event.Created != minStart && !event.Created.After(minStart) {
-					// Not the event we were looking for
-					// log.Println(event.Created, "is not >=", minStart)
-					continue
-				}
-
-				// This is the event we are looking for. Save our place.
-				if lastEventID == 0 {
-					lastEventID = event.ID
-				}
+		cursor = latest
+		if found == nil {
+			return nil, nil
+		}
+		// This is the event we are looking for. Save our place.
+		foundID = found.ID
+		return found, nil
+	}, func(value interface{}) (bool, error) {
+		event, ok := value.(*Event)
+		if !ok {
+			return false, nil
+		}
 
-				switch event.Status {
-				case EventFailed:
-					return &event, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
-				case EventFinished:
-					log.Printf("[INFO] %s %v action %s is finished", titledEntityType, id, action)
-					return &event, nil
-				}
-				// TODO(displague) can we bump the ticker to TimeRemaining/2 (>=1) when non-nil?
-				nextLog = fmt.Sprintf("[INFO] %s %v action %s is %s", titledEntityType, id, action, event.Status)
-			}
+		switch event.Status {
+		case EventFailed:
+			return false, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
+		case EventFinished:
+			logger.Info("Event finished", "entity_type", entityType, "entity_id", id, "action", action, "event_id", event.ID)
+			return true, nil
+		}
 
-			// de-dupe logging statements
-			if nextLog != lastLog {
-				log.Print(nextLog)
-				lastLog = nextLog
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %s", EventFinished, titledEntityType, id, action, ctx.Err())
+		// de-dupe logging statements
+		if event.Status != lastStatus {
+			logger.Info("Event status changed", "entity_type", entityType, "entity_id", id, "action", action,
+				"event_id", event.ID, "status", event.Status)
+			lastStatus = event.Status
 		}
-	}
+		return false, nil
+	}, opts)
+
+	event, _ := value.(*Event)
+	return event, err
 }
@@ -0,0 +1,292 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// InstanceReadyCondition is a condition checked by WaitForInstanceReady
+type InstanceReadyCondition string
+
+// InstanceReadyCondition constants are the conditions of a ready Instance
+const (
+	// InstanceReadyRunning is met once the Instance status is running
+	InstanceReadyRunning InstanceReadyCondition = "running"
+	// InstanceReadyDisks is met once all of the Instance disks are ready
+	InstanceReadyDisks InstanceReadyCondition = "disks"
+	// InstanceReadyBooted is met once the linode_boot Event of the Instance has finished
+	InstanceReadyBooted InstanceReadyCondition = "booted"
+	// InstanceReadyIPs is met once the Instance has a public IPv4 address and a SLAAC IPv6 address
+	InstanceReadyIPs InstanceReadyCondition = "ips"
+)
+
+// InstanceConditionStatus is the state of a condition in an InstanceReadiness report
+type InstanceConditionStatus struct {
+	Condition InstanceReadyCondition
+
+	// Met reports whether the condition was met
+	Met bool
+	// MetAfter is the time the condition took to be met
+	MetAfter time.Duration
+
+	// State describes the latest state polled, such as "status booting"
+	State string
+	// Err is the error of the latest poll, if it failed
+	Err error
+}
+
+// InstanceReadiness reports the conditions checked by WaitForInstanceReady
+type InstanceReadiness struct {
+	InstanceID int
+	// Ready reports whether all of the conditions were met
+	Ready bool
+	// Conditions are the states of the conditions, in the order of the
+	// InstanceReadyCondition constants
+	Conditions []InstanceConditionStatus
+	// Elapsed is the duration of the wait
+	Elapsed time.Duration
+}
+
+// Pending returns the conditions which were not met
+func (r *InstanceReadiness) Pending() []InstanceReadyCondition {
+	var pending []InstanceReadyCondition
+	for _, c := range r.Conditions {
+		if !c.Met {
+			pending = append(pending, c.Condition)
+		}
+	}
+	return pending
+}
+
+// String summarizes the report, such as "Instance 123 pending ips (no SLAAC IPv6)"
+func (r *InstanceReadiness) String() string {
+	if r.Ready {
+		return fmt.Sprintf("Instance %d ready after %v", r.InstanceID, r.Elapsed.Round(time.Second))
+	}
+	return fmt.Sprintf("Instance %d pending %s", r.InstanceID, r.describePending())
+}
+
+// describePending describes the conditions which were not met, with their states
+func (r *InstanceReadiness) describePending() string {
+	var pending []string
+	for _, c := range r.Conditions {
+		if c.Met {
+			continue
+		}
+		switch {
+		case c.Err != nil:
+			pending = append(pending, fmt.Sprintf("%s (%s)", c.Condition, c.Err))
+		case len(c.State) > 0:
+			pending = append(pending, fmt.Sprintf("%s (%s)", c.Condition, c.State))
+		default:
+			pending = append(pending, string(c.Condition))
+		}
+	}
+	return strings.Join(pending, ", ")
+}
+
+// instanceReadyCheck waits for a condition of WaitForInstanceReady
+type instanceReadyCheck struct {
+	condition InstanceReadyCondition
+	wait      func(ctx context.Context, opts []PollOption) error
+	// describe describes the value polled
+	describe func(value interface{}) string
+}
+
+// WaitForInstanceReady waits for the Instance to be usable after being created or
+// booted at minStart: its status is running, all of its disks are ready, its
+// linode_boot Event has finished, and it has both a public IPv4 address and a SLAAC
+// IPv6 address. The conditions are polled concurrently, so callbacks set by opts
+// may be called concurrently. Only the booted condition reports progress to the
+// callback set by WithProgress, with the progress of the linode_boot Event.
+//
+// The returned report shows the state of each condition. When the wait times out,
+// the conditions still pending are given by the report and the error. A failure,
+// such as a failed boot, ends the wait.
+func (client *Client) WaitForInstanceReady(ctx context.Context, instanceID int, minStart time.Time, timeout time.Duration, opts ...PollOption) (_ *InstanceReadiness, err error) {
+	ctx, span := client.startSpan(ctx, "WaitForInstanceReady", SpanAttributeResourceID, instanceID)
+	defer func() { span.end(err) }()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	checks := []instanceReadyCheck{
+		{
+			condition: InstanceReadyRunning,
+			wait: func(ctx context.Context, opts []PollOption) error {
+				// the progress of the boot is reported by the booted condition. opts
+				// is copied, as it is shared by the checks.
+				opts = append(opts[:len(opts):len(opts)], WithProgress(nil))
+				_, err := client.WaitForInstanceStatus(ctx, instanceID, InstanceRunning, 0, opts...)
+				return err
+			},
+			describe: func(value interface{}) string {
+				if instance, ok := value.(*Instance); ok && instance != nil {
+					return fmt.Sprintf("status %s", instance.Status)
+				}
+				return ""
+			},
+		},
+		{
+			condition: InstanceReadyDisks,
+			wait: func(ctx context.Context, opts []PollOption) error {
+				_, err := client.waitFor(ctx, wait{
+					call:        "WaitForInstanceDisksReady",
+					description: fmt.Sprintf("Disks status %s of Instance %d", DiskReady, instanceID),
+					attributes:  []interface{}{SpanAttributeResourceID, instanceID},
+				}, func(ctx context.Context) (interface{}, error) {
+					return client.ListInstanceDisks(ctx, instanceID, nil)
+				}, func(value interface{}) (bool, error) {
+					disks, _ := value.([]InstanceDisk)
+					return len(disks) > 0 && readyDisks(disks) == len(disks), nil
+				}, opts)
+				return err
+			},
+			describe: func(value interface{}) string {
+				disks, _ := value.([]InstanceDisk)
+				return fmt.Sprintf("%d of %d disks ready", readyDisks(disks), len(disks))
+			},
+		},
+		{
+			condition: InstanceReadyBooted,
+			wait: func(ctx context.Context, opts []PollOption) error {
+				_, err := client.WaitForEventFinished(ctx, instanceID, EntityLinode, ActionLinodeBoot, minStart, 0, opts...)
+				return err
+			},
+			describe: func(value interface{}) string {
+				if event, ok := value.(*Event); ok && event != nil {
+					return fmt.Sprintf("event %d %s %d%%", event.ID, event.Status, event.PercentComplete)
+				}
+				return fmt.Sprintf("no %s event", ActionLinodeBoot)
+			},
+		},
+		{
+			condition: InstanceReadyIPs,
+			wait: func(ctx context.Context, opts []PollOption) error {
+				_, err := client.waitFor(ctx, wait{
+					call:        "WaitForInstanceIPAddresses",
+					description: fmt.Sprintf("public IPv4 and SLAAC IPv6 addresses of Instance %d", instanceID),
+					attributes:  []interface{}{SpanAttributeResourceID, instanceID},
+				}, func(ctx context.Context) (interface{}, error) {
+					return client.GetInstanceIPAddresses(ctx, instanceID)
+				}, func(value interface{}) (bool, error) {
+					ips, _ := value.(*InstanceIPAddressResponse)
+					return len(missingIPAddresses(ips)) == 0, nil
+				}, opts)
+				return err
+			},
+			describe: func(value interface{}) string {
+				ips, _ := value.(*InstanceIPAddressResponse)
+				if missing := missingIPAddresses(ips); len(missing) > 0 {
+					return "no " + strings.Join(missing, " or ")
+				}
+				return "public IPv4 and SLAAC IPv6 assigned"
+			},
+		},
+	}
+
+	report := &InstanceReadiness{InstanceID: instanceID, Conditions: make([]InstanceConditionStatus, len(checks))}
+	var (
+		mu      sync.Mutex
+		failure error
+		wg      sync.WaitGroup
+	)
+	start := time.Now()
+	for i, check := range checks {
+		report.Conditions[i].Condition = check.condition
+		status := &report.Conditions[i]
+		check := check
+
+		observe := func(info PollInfo) {
+			if info.Err != nil && ctx.Err() != nil {
+				// the poll was interrupted by the end of the wait, keep the state before
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			status.Err = info.Err
+			if info.Err == nil {
+				status.State = check.describe(info.Value)
+			}
+		}
+		checkOpts := append(append([]PollOption{}, opts...), withPollObserver(observe))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := check.wait(ctx, checkOpts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				status.Met = true
+				status.MetAfter = time.Since(start)
+			case ctx.Err() == nil:
+				// the condition failed, so the others will not all be met
+				status.Err = err
+				if failure == nil {
+					failure = err
+					cancel()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	report.Elapsed = time.Since(start)
+	report.Ready = len(report.Pending()) == 0
+	switch {
+	case report.Ready:
+		client.logger().Info(report.String(), "instance_id", instanceID)
+		return report, nil
+	case failure != nil:
+		return report, failure
+	default:
+		return report, fmt.Errorf("Error waiting for Instance %d to be ready, pending %s: %w", instanceID, report.describePending(), ctx.Err())
+	}
+}
+
+// withPollObserver calls fn after each poll, before the OnPoll callback already set
+func withPollObserver(fn func(info PollInfo)) PollOption {
+	return func(o *PollOptions) {
+		onPoll := o.OnPoll
+		o.OnPoll = func(info PollInfo) {
+			fn(info)
+			if onPoll != nil {
+				onPoll(info)
+			}
+		}
+	}
+}
+
+// readyDisks counts the disks which are ready
+func readyDisks(disks []InstanceDisk) int {
+	ready := 0
+	for _, disk := range disks {
+		if disk.Status == DiskReady {
+			ready++
+		}
+	}
+	return ready
+}
+
+// missingIPAddresses lists the addresses a ready Instance has which are missing
+func missingIPAddresses(ips *InstanceIPAddressResponse) []string {
+	var missing []string
+	if ips == nil || ips.IPv4 == nil || len(ips.IPv4.Public) == 0 {
+		missing = append(missing, "public IPv4")
+	}
+	if ips == nil || ips.IPv6 == nil || ips.IPv6.SLAAC == nil || len(ips.IPv6.SLAAC.Address) == 0 {
+		missing = append(missing, "SLAAC IPv6")
+	}
+	return missing
+}
@@ -0,0 +1,141 @@
+package linodego_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+// readyInstanceHandler serves an Instance becoming ready after the given number of
+// polls, with the boot events served by events. The Instance never gets a SLAAC
+// IPv6 address unless slaac is set.
+func readyInstanceHandler(events *syntheticEvents, polls int32, slaac bool) http.Handler {
+	var instancePolls, diskPolls int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/account/events") {
+			events.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/linode/instances/123":
+			status := InstanceBooting
+			if atomic.AddInt32(&instancePolls, 1) > polls {
+				status = InstanceRunning
+			}
+			fmt.Fprintf(w, `{"id": 123, "status": %q, "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"}`, status)
+		case "/linode/instances/123/disks":
+			status := DiskNotReady
+			if atomic.AddInt32(&diskPolls, 1) > polls {
+				status = DiskReady
+			}
+			fmt.Fprintf(w, `{"page": 1, "pages": 1, "results": 2, "data": [
+				{"id": 1, "status": "ready", "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"},
+				{"id": 2, "status": %q, "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"}]}`, status)
+		case "/linode/instances/123/ips":
+			slaacAddress := ""
+			if slaac {
+				slaacAddress = `{"address": "2600:3c03::f03c:91ff:fe24:3a2f", "prefix": 64, "type": "ipv6"}`
+			} else {
+				slaacAddress = "null"
+			}
+			fmt.Fprintf(w, `{"ipv4": {"public": [{"address": "192.0.2.1", "prefix": 24, "type": "ipv4", "public": true}]},
+				"ipv6": {"slaac": %s}}`, slaacAddress)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	})
+}
+
+func TestWaitForInstanceReady(t *testing.T) {
+	events := newSyntheticEvents(25,
+		syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+		syntheticEvent(2, EntityLinode, 123, ActionLinodeBoot, EventStarted, 0),
+	)
+	client, teardown := createHTTPTestClient(t, readyInstanceHandler(events, 2, true))
+	defer teardown()
+
+	var bootPolls int32
+	var progress []string
+	report, err := client.WaitForInstanceReady(context.Background(), 123, syntheticEventCreated(2), time.Second,
+		func(o *PollOptions) { o.Delay = time.Millisecond },
+		WithOnPoll(func(info PollInfo) {
+			if _, ok := info.Value.(*Event); ok && atomic.AddInt32(&bootPolls, 1) == 3 {
+				events.set(syntheticEvent(2, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100))
+			}
+		}),
+		WithProgress(func(p EventProgress) {
+			// only the booted condition reports progress, so calls are not concurrent
+			progress = append(progress, fmt.Sprintf("%d %s", p.EventID, p.Status))
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Ready || len(report.Pending()) != 0 {
+		t.Fatalf("Expected the Instance to be ready, got %s", report)
+	}
+
+	states := map[InstanceReadyCondition]string{}
+	for _, c := range report.Conditions {
+		if !c.Met || c.MetAfter <= 0 {
+			t.Errorf("Expected %s to be met, got %+v", c.Condition, c)
+		}
+		states[c.Condition] = c.State
+	}
+	expected := map[InstanceReadyCondition]string{
+		InstanceReadyRunning: "status running",
+		InstanceReadyDisks:   "2 of 2 disks ready",
+		InstanceReadyBooted:  "event 2 finished 100%",
+		InstanceReadyIPs:     "public IPv4 and SLAAC IPv6 assigned",
+	}
+	if !reflect.DeepEqual(states, expected) {
+		t.Errorf("Expected the states %v, got %v", expected, states)
+	}
+	if expected := []string{"2 started", "2 finished"}; !reflect.DeepEqual(progress, expected) {
+		t.Errorf("Expected the progress of the boot %v, got %v", expected, progress)
+	}
+}
+
+func TestWaitForInstanceReady_pending(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		events := newSyntheticEvents(25, syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100))
+		client, teardown := createHTTPTestClient(t, readyInstanceHandler(events, 1, false))
+		defer teardown()
+
+		report, err := client.WaitForInstanceReady(context.Background(), 123, syntheticEventCreated(1), 200*time.Millisecond,
+			func(o *PollOptions) { o.Delay = time.Millisecond })
+		if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "pending ips (no SLAAC IPv6)") {
+			t.Errorf("Expected the wait to time out on the IP addresses, got %v", err)
+		}
+		if report == nil || report.Ready || !reflect.DeepEqual(report.Pending(), []InstanceReadyCondition{InstanceReadyIPs}) {
+			t.Errorf("Expected the IP addresses to be pending, got %s", report)
+		}
+	})
+
+	t.Run("failed boot", func(t *testing.T) {
+		events := newSyntheticEvents(25, syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFailed, 0))
+		client, teardown := createHTTPTestClient(t, readyInstanceHandler(events, 1000, true))
+		defer teardown()
+
+		report, err := client.WaitForInstanceReady(context.Background(), 123, syntheticEventCreated(1), time.Second,
+			func(o *PollOptions) { o.Delay = time.Millisecond })
+		if err == nil || !strings.Contains(err.Error(), "failed") || errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected the failed boot to end the wait, got %v", err)
+		}
+		// the other conditions may or may not have been met before the failure
+		booted := report.Conditions[2]
+		if booted.Condition != InstanceReadyBooted || booted.Met || booted.Err == nil || report.Ready {
+			t.Errorf("Expected the boot to have failed, got %s", report)
+		}
+	})
+}
@@ -0,0 +1,315 @@
+package linodego_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/linode/linodego"
+)
+
+func TestWaitFor_backoff(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, typesHandler())
+	defer teardown()
+
+	var polls []PollInfo
+	fetched := 0
+	value, err := client.WaitFor(context.Background(), time.Second,
+		func(ctx context.Context) (interface{}, error) {
+			fetched++
+			return fetched, nil
+		},
+		func(value interface{}) (bool, error) {
+			return value.(int) == 4, nil
+		},
+		func(o *PollOptions) { o.Delay = time.Millisecond },
+		WithBackoff(2, 3*time.Millisecond),
+		WithOnPoll(func(info PollInfo) { polls = append(polls, info) }),
+	)
+	if err != nil || value != 4 {
+		t.Fatalf("Expected the fourth value, got %v and %v", value, err)
+	}
+
+	var delays []time.Duration
+	for i, info := range polls {
+		if info.Attempt != i+1 || info.Value != i+1 || info.Done != (i == 3) {
+			t.Errorf("Unexpected poll %d: %+v", i, info)
+		}
+		delays = append(delays, info.Delay)
+	}
+	expected := []time.Duration{2 * time.Millisecond, 3 * time.Millisecond, 3 * time.Millisecond, 0}
+	if !reflect.DeepEqual(delays, expected) {
+		t.Errorf("Expected the delays %v, got %v", expected, delays)
+	}
+}
+
+func TestWaitForInstanceStatus_transientErrors(t *testing.T) {
+	var requests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		case 2:
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html><body>Bad Gateway</body></html>`))
+		default:
+			_, _ = w.Write([]byte(`{"id": 123, "status": "running", "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"}`))
+		}
+	}))
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.WaitForInstanceStatus(ctx, 123, InstanceRunning, time.Second); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected the 404 to end the wait, got %v", err)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	var transient int
+	instance, err := client.WaitForInstanceStatus(ctx, 123, InstanceRunning, time.Second,
+		WithTransientErrors(2),
+		WithOnPoll(func(info PollInfo) {
+			if info.Transient {
+				transient++
+			}
+		}))
+	if err != nil || instance.Status != InstanceRunning {
+		t.Fatalf("Expected the transient errors to be tolerated, got %v and %v", instance, err)
+	}
+	if transient != 2 {
+		t.Errorf("Expected 2 transient errors, got %d", transient)
+	}
+}
+
+func TestWaitForVolumeStatus_timeout(t *testing.T) {
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "status": "creating", "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"}`))
+	}))
+	defer teardown()
+
+	_, err := client.WaitForVolumeStatus(context.Background(), 123, VolumeActive, 200*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "Volume 123 status active") {
+		t.Errorf("Expected the wait to time out, got %v", err)
+	}
+}
+
+func TestWaitForEventFinished(t *testing.T) {
+	tests := []struct {
+		name     string
+		perPage  int
+		minStart int
+		events   []Event
+		// finish is the event replacing its started version after the second poll
+		finish   Event
+		entityID interface{}
+		entity   EntityType
+		action   EventAction
+		expected string
+		failed   bool
+		// pages are the pages expected to be listed
+		pages []int
+	}{
+		{
+			name:     "seen events",
+			perPage:  25,
+			minStart: 3,
+			events: []Event{
+				syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+				syntheticEvent(2, EntityLinode, 123, ActionLinodeBoot, EventStarted, 0),
+				syntheticEvent(3, EntityLinode, 456, ActionLinodeBoot, EventStarted, 0),
+				syntheticEvent(4, EntityLinode, 123, ActionLinodeReboot, EventFinished, 100),
+				syntheticEvent(5, EntityLinode, 123, ActionLinodeBoot, EventStarted, 10),
+			},
+			finish:   syntheticEvent(5, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+			entityID: 123,
+			entity:   EntityLinode,
+			action:   ActionLinodeBoot,
+			expected: "5 finished",
+			pages:    []int{1},
+		},
+		{
+			name:     "many pages",
+			perPage:  2,
+			minStart: 4,
+			events: []Event{
+				syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+				syntheticEvent(2, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+				syntheticEvent(3, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+				syntheticEvent(4, EntityLinode, 123, ActionLinodeBoot, EventStarted, 0),
+				syntheticEvent(5, EntityLinode, 123, ActionLinodeUpdate, EventNotification, 0),
+				syntheticEvent(6, EntityLinode, 123, ActionLinodeUpdate, EventNotification, 0),
+				syntheticEvent(7, EntityLinode, 123, ActionLinodeUpdate, EventNotification, 0),
+				syntheticEvent(8, EntityLinode, 123, ActionLinodeUpdate, EventNotification, 0),
+			},
+			finish:   syntheticEvent(4, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+			entityID: 123,
+			entity:   EntityLinode,
+			action:   ActionLinodeBoot,
+			expected: "4 finished",
+			// listing stops at the first page with events older than minStart
+			pages: []int{1, 2, 3},
+		},
+		{
+			name:     "string IDs",
+			perPage:  2,
+			minStart: 2,
+			events: []Event{
+				syntheticEvent(1, EntityImage, "private/15", ActionImageDelete, EventFinished, 100),
+				syntheticEvent(2, EntityImage, "private/16", ActionImageDelete, EventStarted, 0),
+				syntheticEvent(3, EntityImage, "private/15", ActionImageDelete, EventStarted, 0),
+			},
+			finish:   syntheticEvent(3, EntityImage, "private/15", ActionImageDelete, EventFinished, 100),
+			entityID: "private/15",
+			entity:   EntityImage,
+			action:   ActionImageDelete,
+			expected: "3 finished",
+			pages:    []int{1, 2},
+		},
+		{
+			name:     "later events",
+			perPage:  2,
+			minStart: 2,
+			events: []Event{
+				syntheticEvent(1, EntityStackScript, 10, ActionStackScriptCreate, EventFinished, 100),
+			},
+			finish:   syntheticEvent(2, EntityStackScript, 10, ActionStackScriptCreate, EventFinished, 100),
+			entityID: 10,
+			entity:   EntityStackScript,
+			action:   ActionStackScriptCreate,
+			expected: "2 finished",
+			pages:    []int{1, 1, 1},
+		},
+		{
+			name:     "failed",
+			perPage:  2,
+			minStart: 1,
+			events: []Event{
+				syntheticEvent(1, EntityDisk, 7, ActionDiskResize, EventStarted, 0),
+			},
+			finish:   syntheticEvent(1, EntityDisk, 7, ActionDiskResize, EventFailed, 0),
+			entityID: 7,
+			entity:   EntityDisk,
+			action:   ActionDiskResize,
+			expected: "1 failed",
+			failed:   true,
+			pages:    []int{1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the events have been seen by another client
+			for i := range test.events {
+				test.events[i].Seen = true
+			}
+			server := newSyntheticEvents(test.perPage, test.events...)
+			client, teardown := createHTTPTestClient(t, server)
+			defer teardown()
+
+			event, err := client.WaitForEventFinished(context.Background(), test.entityID, test.entity, test.action,
+				syntheticEventCreated(test.minStart), time.Second,
+				func(o *PollOptions) { o.Delay = time.Millisecond },
+				WithOnPoll(func(info PollInfo) {
+					if info.Attempt == 2 {
+						server.set(test.finish)
+					}
+				}))
+			if (err != nil) != test.failed {
+				t.Fatalf("Expected failed to be %v, got %v", test.failed, err)
+			}
+			if event == nil || fmt.Sprintf("%d %s", event.ID, event.Status) != test.expected {
+				t.Fatalf("Expected event %q, got %+v", test.expected, event)
+			}
+			if !reflect.DeepEqual(server.pages, test.pages) {
+				t.Errorf("Expected the pages %v to be listed, got %v", test.pages, server.pages)
+			}
+		})
+	}
+}
+
+func TestWaitForEventFinished_progress(t *testing.T) {
+	started := syntheticEvent(1, EntityLinode, 123, ActionLinodeMigrate, EventStarted, 0)
+	started.TimeRemainingMsg = json.RawMessage(`"00:10:00"`)
+	server := newSyntheticEvents(25, started)
+	client, teardown := createHTTPTestClient(t, server)
+	defer teardown()
+
+	var progress []string
+	var delays []time.Duration
+	_, err := client.WaitForEventFinished(context.Background(), 123, EntityLinode, ActionLinodeMigrate,
+		syntheticEventCreated(1), time.Second,
+		func(o *PollOptions) { o.Delay = time.Millisecond },
+		WithAdaptiveDelay(5*time.Millisecond),
+		WithProgress(func(p EventProgress) {
+			progress = append(progress, fmt.Sprintf("%d %s %d %s", p.EventID, p.Status, p.PercentComplete, p.TimeRemaining))
+		}),
+		WithOnPoll(func(info PollInfo) {
+			delays = append(delays, info.Delay)
+			switch info.Attempt {
+			case 2:
+				event := syntheticEvent(1, EntityLinode, 123, ActionLinodeMigrate, EventStarted, 50)
+				event.TimeRemainingMsg = json.RawMessage(`0`)
+				server.set(event)
+			case 3:
+				server.set(syntheticEvent(1, EntityLinode, 123, ActionLinodeMigrate, EventFinished, 100))
+			}
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the unchanged second poll is not reported
+	expectedProgress := []string{"1 started 0 10m0s", "1 started 50 0s", "1 finished 100 0s"}
+	if !reflect.DeepEqual(progress, expectedProgress) {
+		t.Errorf("Expected the progress %v, got %v", expectedProgress, progress)
+	}
+	// half of the time remaining, bounded by the poll delay and the adaptive maximum
+	expectedDelays := []time.Duration{5 * time.Millisecond, 5 * time.Millisecond, time.Millisecond, 0}
+	if !reflect.DeepEqual(delays, expectedDelays) {
+		t.Errorf("Expected the delays %v, got %v", expectedDelays, delays)
+	}
+}
+
+func TestWaitForInstanceStatus_progress(t *testing.T) {
+	events := newSyntheticEvents(25,
+		syntheticEvent(1, EntityLinode, 123, ActionLinodeBoot, EventFinished, 100),
+		syntheticEvent(2, EntityLinode, 123, ActionLinodeResize, EventStarted, 40),
+	)
+	var instanceRequests int32
+	client, teardown := createHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/account/events") {
+			events.ServeHTTP(w, r)
+			return
+		}
+		status := InstanceResizing
+		if atomic.AddInt32(&instanceRequests, 1) > 1 {
+			status = InstanceRunning
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": 123, "status": %q, "created": "2018-01-02T03:04:05", "updated": "2018-01-02T03:04:05"}`, status)
+	}))
+	defer teardown()
+
+	var progress []EventProgress
+	instance, err := client.WaitForInstanceStatus(context.Background(), 123, InstanceRunning, time.Second,
+		WithProgress(func(p EventProgress) {
+			events.set(syntheticEvent(2, EntityLinode, 123, ActionLinodeResize, EventFinished, 100))
+			progress = append(progress, p)
+		}))
+	if err != nil || instance.Status != InstanceRunning {
+		t.Fatalf("Expected the Instance to be running, got %v and %v", instance, err)
+	}
+	if len(progress) != 1 || progress[0].EventID != 2 || progress[0].Action != ActionLinodeResize || progress[0].PercentComplete != 40 {
+		t.Errorf("Expected the progress of the resize, got %+v", progress)
+	}
+}